@@ -34,19 +34,25 @@ type MeshProvider int32
 const (
 	// the Operator will utilize Istio mesh for metrics and configuration
 	MeshProvider_Istio MeshProvider = 0
-	// the Operator will utilize a locally deployed Prometheus instance for metrics
-	// (Currently unimplemented)
+	// the Operator will utilize a locally deployed Prometheus instance for metrics,
+	// configured by the metricsServer of the AutopilotOperator
 	MeshProvider_Custom MeshProvider = 1
+	// the Operator will utilize the Prometheus instance deployed with the Linkerd control plane
+	// (linkerd-prometheus in the controlPlaneNs) for metrics.
+	// Linkerd success-rate and latency queries are generated for the metrics client
+	MeshProvider_Linkerd MeshProvider = 2
 )
 
 var MeshProvider_name = map[int32]string{
 	0: "Istio",
 	1: "Custom",
+	2: "Linkerd",
 }
 
 var MeshProvider_value = map[string]int32{
-	"Istio":  0,
-	"Custom": 1,
+	"Istio":   0,
+	"Custom":  1,
+	"Linkerd": 2,
 }
 
 func (x MeshProvider) String() string {
@@ -74,6 +80,7 @@ type AutopilotOperator struct {
 	// Default is "istio-system"
 	ControlPlaneNs string `protobuf:"bytes,3,opt,name=controlPlaneNs,proto3" json:"controlPlaneNs,omitempty"`
 	// workInterval to sets the interval at which CRD workers resync.
+	// Individual phases may override this with their resyncInterval.
 	// Default is 5s
 	WorkInterval *duration.Duration `protobuf:"bytes,4,opt,name=workInterval,proto3" json:"workInterval,omitempty"`
 	// Serve metrics on this address. Set to empty string to disable metrics
@@ -83,6 +90,7 @@ type AutopilotOperator struct {
 	// defaults to true
 	EnableLeaderElection bool `protobuf:"varint,6,opt,name=enableLeaderElection,proto3" json:"enableLeaderElection,omitempty"`
 	// if non-empty, watchNamespace will restrict the Operator to watching resources in a single namespace
+	// and restrict the Operator's cache to that namespace
 	// if empty (default), the Operator must have Cluster-scope RBAC permissions (ClusterRole/Binding)
 	// can also be set via the WATCH_NAMESPACE environment variable
 	WatchNamespace string `protobuf:"bytes,7,opt,name=watchNamespace,proto3" json:"watchNamespace,omitempty"`
@@ -99,10 +107,21 @@ type AutopilotOperator struct {
 	// 5 - Panic
 	// 6 - Fatal
 	// Defaults to Info
-	LogLevel             *wrappers.UInt32Value `protobuf:"bytes,9,opt,name=logLevel,proto3" json:"logLevel,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	LogLevel *wrappers.UInt32Value `protobuf:"bytes,9,opt,name=logLevel,proto3" json:"logLevel,omitempty"`
+	// Serve admission webhooks on this port. Only used if webhooks are enabled in the autopilot.yaml
+	// defaults to 9443
+	WebhookPort uint32 `protobuf:"varint,10,opt,name=webhookPort,proto3" json:"webhookPort,omitempty"`
+	// Directory containing the TLS certificate (tls.crt) and key (tls.key) used to serve admission webhooks.
+	// Only used if webhooks are enabled in the autopilot.yaml
+	// defaults to "/tmp/k8s-webhook-server/serving-certs"
+	WebhookCertDir string `protobuf:"bytes,11,opt,name=webhookCertDir,proto3" json:"webhookCertDir,omitempty"`
+	// metricsServer configures the connection to the Prometheus server queried for metrics.
+	// Required for the Custom meshProvider. For other providers, an explicit url or discovery
+	// overrides the provider's default server
+	MetricsServer        *MetricsServer `protobuf:"bytes,12,opt,name=metricsServer,proto3" json:"metricsServer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
 func (m *AutopilotOperator) Reset()         { *m = AutopilotOperator{} }
@@ -193,37 +212,392 @@ func (m *AutopilotOperator) GetLogLevel() *wrappers.UInt32Value {
 	return nil
 }
 
+func (m *AutopilotOperator) GetWebhookPort() uint32 {
+	if m != nil {
+		return m.WebhookPort
+	}
+	return 0
+}
+
+func (m *AutopilotOperator) GetWebhookCertDir() string {
+	if m != nil {
+		return m.WebhookCertDir
+	}
+	return ""
+}
+
+func (m *AutopilotOperator) GetMetricsServer() *MetricsServer {
+	if m != nil {
+		return m.MetricsServer
+	}
+	return nil
+}
+
+// MetricsServer configures the connection to a Prometheus server.
+// The METRICS_SERVER environment variable takes precedence over the url and discovery
+type MetricsServer struct {
+	// the address of the Prometheus API, e.g. `http://prometheus.monitoring:9090`.
+	// takes precedence over discovery
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// discover the address of an in-cluster Prometheus by the labels of its Service
+	Discovery *PrometheusDiscovery `protobuf:"bytes,2,opt,name=discovery,proto3" json:"discovery,omitempty"`
+	// the timeout for each query.
+	// defaults to no timeout
+	Timeout *duration.Duration `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// credentials for HTTP basic authentication
+	BasicAuth *BasicAuth `protobuf:"bytes,4,opt,name=basicAuth,proto3" json:"basicAuth,omitempty"`
+	// a bearer token sent with each query.
+	// deprecated: the token is stored in plain text in the Operator's ConfigMap, use bearerTokenFile instead
+	BearerToken string `protobuf:"bytes,5,opt,name=bearerToken,proto3" json:"bearerToken,omitempty"`
+	// a file containing the bearer token sent with each query, e.g. a mounted Secret.
+	// the token is reloaded when the file changes.
+	// takes precedence over bearerToken
+	BearerTokenFile string `protobuf:"bytes,6,opt,name=bearerTokenFile,proto3" json:"bearerTokenFile,omitempty"`
+	// TLS configuration for connecting to the server.
+	// when set, discovered servers are addressed with https
+	Tls                  *MetricsServerTLS `protobuf:"bytes,7,opt,name=tls,proto3" json:"tls,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *MetricsServer) Reset()         { *m = MetricsServer{} }
+func (m *MetricsServer) String() string { return proto.CompactTextString(m) }
+func (*MetricsServer) ProtoMessage()    {}
+func (*MetricsServer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56f975433f2c607a, []int{1}
+}
+
+func (m *MetricsServer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MetricsServer.Unmarshal(m, b)
+}
+func (m *MetricsServer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MetricsServer.Marshal(b, m, deterministic)
+}
+func (m *MetricsServer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetricsServer.Merge(m, src)
+}
+func (m *MetricsServer) XXX_Size() int {
+	return xxx_messageInfo_MetricsServer.Size(m)
+}
+func (m *MetricsServer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetricsServer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MetricsServer proto.InternalMessageInfo
+
+func (m *MetricsServer) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *MetricsServer) GetDiscovery() *PrometheusDiscovery {
+	if m != nil {
+		return m.Discovery
+	}
+	return nil
+}
+
+func (m *MetricsServer) GetTimeout() *duration.Duration {
+	if m != nil {
+		return m.Timeout
+	}
+	return nil
+}
+
+func (m *MetricsServer) GetBasicAuth() *BasicAuth {
+	if m != nil {
+		return m.BasicAuth
+	}
+	return nil
+}
+
+func (m *MetricsServer) GetBearerToken() string {
+	if m != nil {
+		return m.BearerToken
+	}
+	return ""
+}
+
+func (m *MetricsServer) GetBearerTokenFile() string {
+	if m != nil {
+		return m.BearerTokenFile
+	}
+	return ""
+}
+
+func (m *MetricsServer) GetTls() *MetricsServerTLS {
+	if m != nil {
+		return m.Tls
+	}
+	return nil
+}
+
+// MetricsServerTLS configures TLS, including mutual TLS, for the connection to a Prometheus server.
+// All files are reloaded when they change, e.g. when a mounted Secret is rotated
+type MetricsServerTLS struct {
+	// a file containing the PEM-encoded CA certificates used to verify the server.
+	// defaults to the system roots
+	CaFile string `protobuf:"bytes,1,opt,name=caFile,proto3" json:"caFile,omitempty"`
+	// files containing the PEM-encoded client certificate and key presented to the server for mutual TLS
+	CertFile string `protobuf:"bytes,2,opt,name=certFile,proto3" json:"certFile,omitempty"`
+	KeyFile  string `protobuf:"bytes,3,opt,name=keyFile,proto3" json:"keyFile,omitempty"`
+	// the name used to verify the server certificate.
+	// defaults to the host of the server address
+	ServerName string `protobuf:"bytes,4,opt,name=serverName,proto3" json:"serverName,omitempty"`
+	// skip verification of the server certificate. insecure, for testing only
+	InsecureSkipVerify   bool     `protobuf:"varint,5,opt,name=insecureSkipVerify,proto3" json:"insecureSkipVerify,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MetricsServerTLS) Reset()         { *m = MetricsServerTLS{} }
+func (m *MetricsServerTLS) String() string { return proto.CompactTextString(m) }
+func (*MetricsServerTLS) ProtoMessage()    {}
+func (*MetricsServerTLS) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56f975433f2c607a, []int{2}
+}
+
+func (m *MetricsServerTLS) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MetricsServerTLS.Unmarshal(m, b)
+}
+func (m *MetricsServerTLS) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MetricsServerTLS.Marshal(b, m, deterministic)
+}
+func (m *MetricsServerTLS) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetricsServerTLS.Merge(m, src)
+}
+func (m *MetricsServerTLS) XXX_Size() int {
+	return xxx_messageInfo_MetricsServerTLS.Size(m)
+}
+func (m *MetricsServerTLS) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetricsServerTLS.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MetricsServerTLS proto.InternalMessageInfo
+
+func (m *MetricsServerTLS) GetCaFile() string {
+	if m != nil {
+		return m.CaFile
+	}
+	return ""
+}
+
+func (m *MetricsServerTLS) GetCertFile() string {
+	if m != nil {
+		return m.CertFile
+	}
+	return ""
+}
+
+func (m *MetricsServerTLS) GetKeyFile() string {
+	if m != nil {
+		return m.KeyFile
+	}
+	return ""
+}
+
+func (m *MetricsServerTLS) GetServerName() string {
+	if m != nil {
+		return m.ServerName
+	}
+	return ""
+}
+
+func (m *MetricsServerTLS) GetInsecureSkipVerify() bool {
+	if m != nil {
+		return m.InsecureSkipVerify
+	}
+	return false
+}
+
+// PrometheusDiscovery finds a Prometheus Service in the cluster.
+// The Operator requires permission to list Services in the namespace,
+// which `ap generate` grants with the input Role of the namespace (deploy/role-inputs-<namespace>.yaml)
+type PrometheusDiscovery struct {
+	// the namespace of the Service.
+	// defaults to the controlPlaneNs
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// the labels of the Service, e.g. `app: prometheus`.
+	// exactly one Service must match
+	Labels map[string]string `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// the name of the Service port serving the Prometheus API.
+	// defaults to the first port of the Service
+	Port                 string   `protobuf:"bytes,3,opt,name=port,proto3" json:"port,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PrometheusDiscovery) Reset()         { *m = PrometheusDiscovery{} }
+func (m *PrometheusDiscovery) String() string { return proto.CompactTextString(m) }
+func (*PrometheusDiscovery) ProtoMessage()    {}
+func (*PrometheusDiscovery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56f975433f2c607a, []int{3}
+}
+
+func (m *PrometheusDiscovery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PrometheusDiscovery.Unmarshal(m, b)
+}
+func (m *PrometheusDiscovery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PrometheusDiscovery.Marshal(b, m, deterministic)
+}
+func (m *PrometheusDiscovery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrometheusDiscovery.Merge(m, src)
+}
+func (m *PrometheusDiscovery) XXX_Size() int {
+	return xxx_messageInfo_PrometheusDiscovery.Size(m)
+}
+func (m *PrometheusDiscovery) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrometheusDiscovery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrometheusDiscovery proto.InternalMessageInfo
+
+func (m *PrometheusDiscovery) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *PrometheusDiscovery) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *PrometheusDiscovery) GetPort() string {
+	if m != nil {
+		return m.Port
+	}
+	return ""
+}
+
+// BasicAuth contains the credentials for HTTP basic authentication
+type BasicAuth struct {
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// deprecated: the password is stored in plain text in the Operator's ConfigMap, use passwordFile instead
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	// a file containing the password, e.g. a mounted Secret.
+	// the password is reloaded when the file changes.
+	// takes precedence over password
+	PasswordFile         string   `protobuf:"bytes,3,opt,name=passwordFile,proto3" json:"passwordFile,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BasicAuth) Reset()         { *m = BasicAuth{} }
+func (m *BasicAuth) String() string { return proto.CompactTextString(m) }
+func (*BasicAuth) ProtoMessage()    {}
+func (*BasicAuth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56f975433f2c607a, []int{4}
+}
+
+func (m *BasicAuth) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BasicAuth.Unmarshal(m, b)
+}
+func (m *BasicAuth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BasicAuth.Marshal(b, m, deterministic)
+}
+func (m *BasicAuth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BasicAuth.Merge(m, src)
+}
+func (m *BasicAuth) XXX_Size() int {
+	return xxx_messageInfo_BasicAuth.Size(m)
+}
+func (m *BasicAuth) XXX_DiscardUnknown() {
+	xxx_messageInfo_BasicAuth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BasicAuth proto.InternalMessageInfo
+
+func (m *BasicAuth) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *BasicAuth) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+func (m *BasicAuth) GetPasswordFile() string {
+	if m != nil {
+		return m.PasswordFile
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("autopilot.MeshProvider", MeshProvider_name, MeshProvider_value)
 	proto.RegisterType((*AutopilotOperator)(nil), "autopilot.AutopilotOperator")
+	proto.RegisterType((*MetricsServer)(nil), "autopilot.MetricsServer")
+	proto.RegisterType((*MetricsServerTLS)(nil), "autopilot.MetricsServerTLS")
+	proto.RegisterType((*PrometheusDiscovery)(nil), "autopilot.PrometheusDiscovery")
+	proto.RegisterMapType((map[string]string)(nil), "autopilot.PrometheusDiscovery.LabelsEntry")
+	proto.RegisterType((*BasicAuth)(nil), "autopilot.BasicAuth")
 }
 
 func init() { proto.RegisterFile("autopilot-operator.proto", fileDescriptor_56f975433f2c607a) }
 
 var fileDescriptor_56f975433f2c607a = []byte{
-	// 377 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x4d, 0xcb, 0xd3, 0x40,
-	0x14, 0x85, 0x8d, 0xaf, 0xfd, 0xc8, 0xb4, 0x94, 0x3a, 0x08, 0x1d, 0x45, 0x24, 0x28, 0x95, 0x20,
-	0x34, 0xc1, 0x74, 0x53, 0x10, 0x17, 0xf5, 0x63, 0x51, 0xa8, 0xb5, 0x04, 0x74, 0xe1, 0x6e, 0x92,
-	0x5c, 0xd3, 0xc1, 0x49, 0x6e, 0x98, 0x99, 0xa4, 0x3f, 0xd0, 0x3f, 0x26, 0x4d, 0xda, 0xd8, 0x58,
-	0xdf, 0x65, 0xee, 0x73, 0xee, 0x3d, 0xe7, 0x64, 0x08, 0xe3, 0xa5, 0xc1, 0x42, 0x48, 0x34, 0x0b,
-	0x2c, 0x40, 0x71, 0x83, 0xca, 0x2b, 0x14, 0x1a, 0xa4, 0x76, 0x4b, 0x9e, 0xbd, 0x48, 0x11, 0x53,
-	0x09, 0x7e, 0x0d, 0xa2, 0xf2, 0xa7, 0x9f, 0x94, 0x8a, 0x1b, 0x81, 0x79, 0x23, 0xbd, 0xe5, 0x47,
-	0xc5, 0x8b, 0x02, 0x94, 0x6e, 0xf8, 0xcb, 0xdf, 0x77, 0xe4, 0xf1, 0xfa, 0x72, 0xed, 0xeb, 0xd9,
-	0x86, 0x32, 0x32, 0xa8, 0x40, 0x69, 0x81, 0x39, 0xb3, 0x1c, 0xcb, 0xb5, 0xc3, 0xcb, 0x27, 0x7d,
-	0x47, 0xc6, 0x19, 0xe8, 0xc3, 0x5e, 0x61, 0x25, 0x12, 0x50, 0xec, 0xa1, 0x63, 0xb9, 0x93, 0x60,
-	0xe6, 0xb5, 0x89, 0xbc, 0x2f, 0x57, 0x38, 0xec, 0x88, 0xe9, 0x6b, 0x32, 0x89, 0x31, 0x37, 0x0a,
-	0xe5, 0x5e, 0xf2, 0x1c, 0x76, 0x9a, 0xdd, 0xd5, 0xd7, 0xff, 0x99, 0xd2, 0xf7, 0x64, 0x7c, 0x44,
-	0xf5, 0x6b, 0x93, 0x1b, 0x50, 0x15, 0x97, 0xec, 0x91, 0x63, 0xb9, 0xa3, 0xe0, 0xa9, 0xd7, 0x74,
-	0xf1, 0x2e, 0x5d, 0xbc, 0x4f, 0xe7, 0xae, 0x61, 0x47, 0x4e, 0x1d, 0x32, 0xca, 0xc0, 0x28, 0x11,
-	0xeb, 0x75, 0x92, 0x28, 0xd6, 0xab, 0x3d, 0xae, 0x47, 0x34, 0x20, 0x4f, 0x20, 0xe7, 0x91, 0x84,
-	0x2d, 0xf0, 0x04, 0xd4, 0x67, 0x09, 0xf1, 0xe9, 0x0e, 0xeb, 0x3b, 0x96, 0x3b, 0x0c, 0xff, 0xcb,
-	0x4e, 0xe1, 0x8f, 0xdc, 0xc4, 0x87, 0x1d, 0xcf, 0x40, 0x17, 0x3c, 0x06, 0x36, 0x68, 0xc2, 0x77,
-	0xa7, 0x74, 0x45, 0x66, 0xb2, 0xb3, 0xf9, 0x77, 0x61, 0x58, 0x2f, 0xdc, 0x87, 0xe9, 0x8a, 0x0c,
-	0x25, 0xa6, 0x5b, 0xa8, 0x40, 0x32, 0xbb, 0xae, 0xfc, 0xfc, 0xa6, 0xf2, 0xb7, 0x4d, 0x6e, 0x96,
-	0xc1, 0x77, 0x2e, 0x4b, 0x08, 0x5b, 0xf5, 0x9b, 0x39, 0x19, 0x5f, 0xff, 0x76, 0x6a, 0x93, 0xde,
-	0x46, 0x1b, 0x81, 0xd3, 0x07, 0x94, 0x90, 0xfe, 0xc7, 0x52, 0x1b, 0xcc, 0xa6, 0xd6, 0x87, 0xf9,
-	0x8f, 0x57, 0xa9, 0x30, 0x87, 0x32, 0xf2, 0x62, 0xcc, 0x7c, 0x8d, 0x12, 0x17, 0x02, 0xfd, 0xf6,
-	0xe9, 0x7c, 0x5e, 0x08, 0xbf, 0x7a, 0x1b, 0xf5, 0x6b, 0xb7, 0xe5, 0x9f, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x7b, 0xd0, 0xeb, 0x13, 0x81, 0x02, 0x00, 0x00,
+	// 761 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0x4d, 0x6f, 0xdb, 0x46,
+	0x10, 0x2d, 0x25, 0x5b, 0x16, 0x47, 0x72, 0xaa, 0x6e, 0x8d, 0x86, 0x75, 0x03, 0x43, 0x50, 0xd1,
+	0x42, 0x08, 0x60, 0x0a, 0xa5, 0x2f, 0xee, 0x27, 0x60, 0xc7, 0x29, 0x60, 0x40, 0x4d, 0x0d, 0x3a,
+	0xcd, 0xa1, 0xb7, 0x15, 0x35, 0x91, 0x16, 0x5a, 0x71, 0x89, 0xd9, 0xa5, 0x04, 0xfd, 0xab, 0xfe,
+	0x87, 0x02, 0xbd, 0xf5, 0x3f, 0x15, 0x5c, 0x7e, 0x88, 0x74, 0xec, 0xe6, 0xb6, 0x33, 0xef, 0xcd,
+	0xee, 0xcc, 0xe3, 0x1b, 0x82, 0xc7, 0x53, 0xa3, 0x12, 0x21, 0x95, 0x39, 0x57, 0x09, 0x12, 0x37,
+	0x8a, 0xfc, 0x84, 0x94, 0x51, 0xcc, 0xad, 0x90, 0xd3, 0xb3, 0x85, 0x52, 0x0b, 0x89, 0x13, 0x0b,
+	0xcc, 0xd2, 0xf7, 0x93, 0x79, 0x4a, 0xdc, 0x08, 0x15, 0xe7, 0xd4, 0x0f, 0xf1, 0x2d, 0xf1, 0x24,
+	0x41, 0xd2, 0x39, 0x3e, 0xfa, 0xf7, 0x00, 0x3e, 0xbb, 0x2a, 0x6f, 0xfb, 0xbd, 0x78, 0x86, 0x79,
+	0x70, 0xb4, 0x41, 0xd2, 0x42, 0xc5, 0x9e, 0x33, 0x74, 0xc6, 0x6e, 0x58, 0x86, 0xec, 0x47, 0xe8,
+	0xaf, 0x51, 0x2f, 0xef, 0x48, 0x6d, 0xc4, 0x1c, 0xc9, 0x6b, 0x0d, 0x9d, 0xf1, 0xb3, 0xe0, 0xb9,
+	0x5f, 0x75, 0xe4, 0xff, 0x56, 0x83, 0xc3, 0x06, 0x99, 0x7d, 0x0b, 0xcf, 0x22, 0x15, 0x1b, 0x52,
+	0xf2, 0x4e, 0xf2, 0x18, 0xdf, 0x68, 0xaf, 0x6d, 0x6f, 0x7f, 0x90, 0x65, 0x3f, 0x43, 0x7f, 0xab,
+	0x68, 0x75, 0x1b, 0x1b, 0xa4, 0x0d, 0x97, 0xde, 0xc1, 0xd0, 0x19, 0xf7, 0x82, 0x2f, 0xfd, 0x7c,
+	0x16, 0xbf, 0x9c, 0xc5, 0xbf, 0x29, 0x66, 0x0d, 0x1b, 0x74, 0x36, 0x84, 0xde, 0x1a, 0x0d, 0x89,
+	0x48, 0x5f, 0xcd, 0xe7, 0xe4, 0x1d, 0xda, 0x37, 0xea, 0x29, 0x16, 0xc0, 0x09, 0xc6, 0x7c, 0x26,
+	0x71, 0x8a, 0x7c, 0x8e, 0xf4, 0x5a, 0x62, 0x94, 0xdd, 0xe3, 0x75, 0x86, 0xce, 0xb8, 0x1b, 0x3e,
+	0x8a, 0x65, 0xcd, 0x6f, 0xb9, 0x89, 0x96, 0x6f, 0xf8, 0x1a, 0x75, 0xc2, 0x23, 0xf4, 0x8e, 0xf2,
+	0xe6, 0x9b, 0x59, 0x76, 0x09, 0xcf, 0x65, 0xa3, 0x72, 0x5f, 0xd0, 0xb5, 0x05, 0x4f, 0xc1, 0xec,
+	0x12, 0xba, 0x52, 0x2d, 0xa6, 0xb8, 0x41, 0xe9, 0xb9, 0x76, 0xe4, 0x17, 0x1f, 0x8c, 0xfc, 0xc7,
+	0x6d, 0x6c, 0x2e, 0x82, 0x77, 0x5c, 0xa6, 0x18, 0x56, 0xec, 0x6c, 0xe2, 0x2d, 0xce, 0x96, 0x4a,
+	0xad, 0xee, 0x14, 0x19, 0x0f, 0x86, 0xce, 0xf8, 0x38, 0xac, 0xa7, 0x6c, 0xf7, 0x79, 0xf8, 0x0a,
+	0xc9, 0xdc, 0x08, 0xf2, 0x7a, 0x45, 0xf7, 0x8d, 0x2c, 0xfb, 0x05, 0x8e, 0x0b, 0xa1, 0xee, 0x91,
+	0x36, 0x48, 0x5e, 0xdf, 0x36, 0xe2, 0x35, 0x3e, 0x70, 0x0d, 0x0f, 0x9b, 0xf4, 0xd1, 0xdf, 0x2d,
+	0x38, 0x6e, 0x10, 0xd8, 0x00, 0xda, 0x29, 0xc9, 0xc2, 0x47, 0xd9, 0x91, 0xfd, 0x04, 0xee, 0x5c,
+	0xe8, 0x48, 0x6d, 0x90, 0x76, 0xd6, 0x40, 0xbd, 0xe0, 0xac, 0x76, 0xff, 0x1d, 0xa9, 0x35, 0x9a,
+	0x25, 0xa6, 0xfa, 0xa6, 0x64, 0x85, 0xfb, 0x02, 0x76, 0x01, 0x47, 0x46, 0xac, 0x51, 0xa5, 0xc6,
+	0x6b, 0x7f, 0xcc, 0x17, 0x25, 0x93, 0x05, 0xe0, 0xce, 0xb8, 0x16, 0xd1, 0x55, 0x6a, 0x96, 0x85,
+	0x9d, 0x4e, 0x6a, 0x4f, 0x5e, 0x97, 0x58, 0xb8, 0xa7, 0x65, 0xa2, 0xce, 0x90, 0x13, 0xd2, 0x5b,
+	0xb5, 0xc2, 0xb8, 0xb4, 0x51, 0x2d, 0xc5, 0xc6, 0xf0, 0x69, 0x2d, 0xfc, 0x55, 0x48, 0xb4, 0x0e,
+	0x72, 0xc3, 0x87, 0x69, 0x76, 0x0e, 0x6d, 0x23, 0xb5, 0x75, 0x4c, 0x2f, 0xf8, 0xea, 0x29, 0x31,
+	0xdf, 0x4e, 0xef, 0xc3, 0x8c, 0x37, 0xfa, 0xcb, 0x81, 0xc1, 0x43, 0x84, 0x7d, 0x01, 0x9d, 0x88,
+	0xdb, 0x47, 0x72, 0x2d, 0x8b, 0x88, 0x9d, 0x42, 0x37, 0x42, 0x32, 0x16, 0x69, 0x59, 0xa4, 0x8a,
+	0xb3, 0x45, 0x5e, 0xe1, 0xce, 0x42, 0xf9, 0xaa, 0x95, 0x21, 0x3b, 0x03, 0xd0, 0xf6, 0xea, 0xcc,
+	0x7f, 0x56, 0x12, 0x37, 0xac, 0x65, 0x98, 0x0f, 0x4c, 0xc4, 0x1a, 0xa3, 0x94, 0xf0, 0x7e, 0x25,
+	0x92, 0x77, 0x48, 0xe2, 0xfd, 0xce, 0x8a, 0xd0, 0x0d, 0x1f, 0x41, 0x46, 0xff, 0x38, 0xf0, 0xf9,
+	0x23, 0x5f, 0x8e, 0xbd, 0x00, 0x37, 0xae, 0x16, 0x20, 0x6f, 0x7c, 0x9f, 0x60, 0xd7, 0xd0, 0x91,
+	0x7c, 0x86, 0x52, 0x7b, 0xad, 0x61, 0x7b, 0xdc, 0x0b, 0x5e, 0xfe, 0xbf, 0x0f, 0xfc, 0xa9, 0x25,
+	0xbf, 0x8e, 0x0d, 0xed, 0xc2, 0xa2, 0x92, 0x31, 0x38, 0x48, 0x32, 0xd7, 0xe7, 0x03, 0xda, 0xf3,
+	0xe9, 0xf7, 0xd0, 0xab, 0x51, 0x33, 0x0f, 0xae, 0x70, 0x57, 0x7a, 0x70, 0x85, 0x3b, 0x76, 0x02,
+	0x87, 0x9b, 0x6c, 0x89, 0x0a, 0xc5, 0xf2, 0xe0, 0x87, 0xd6, 0xa5, 0x33, 0x5a, 0x80, 0x5b, 0xd9,
+	0x21, 0xd3, 0x36, 0xd5, 0x48, 0x59, 0xc3, 0x45, 0x75, 0x15, 0x67, 0x58, 0xc2, 0xb5, 0xde, 0x2a,
+	0x9a, 0x97, 0xba, 0x97, 0x31, 0x1b, 0x41, 0xbf, 0x3c, 0xd7, 0xc4, 0x6f, 0xe4, 0x5e, 0x06, 0xd0,
+	0xaf, 0xff, 0x2b, 0x99, 0x0b, 0x87, 0xb7, 0xda, 0x08, 0x35, 0xf8, 0x84, 0x01, 0x74, 0x5e, 0xa5,
+	0xda, 0xa8, 0xf5, 0xc0, 0x61, 0x3d, 0x38, 0x9a, 0x8a, 0x78, 0x85, 0x34, 0x1f, 0xb4, 0xae, 0xbf,
+	0xf9, 0xf3, 0xeb, 0x85, 0x30, 0xcb, 0x74, 0xe6, 0x47, 0x6a, 0x3d, 0xd1, 0x4a, 0xaa, 0x73, 0xa1,
+	0x26, 0x95, 0x66, 0x13, 0x9e, 0x88, 0xc9, 0xe6, 0xbb, 0x59, 0xc7, 0xae, 0xc2, 0xc5, 0x7f, 0x03,
+	0x00, 0xad, 0x02, 0xe0, 0xce, 0x43, 0x06, 0x00, 0x00,
 }
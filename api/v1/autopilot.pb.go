@@ -8,6 +8,7 @@ import (
 	math "math"
 
 	proto "github.com/golang/protobuf/proto"
+	duration "github.com/golang/protobuf/ptypes/duration"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -21,6 +22,72 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+// Mapping determines how a changed input is mapped to the top-level CRDs to reconcile
+type InputWatch_Mapping int32
+
+const (
+	// reconcile the CRD which is the controller owner of the input.
+	// inputs which are also outputs are always watched this way, and must not declare it
+	InputWatch_OwnerReference InputWatch_Mapping = 0
+	// reconcile the CRD named by the value of the input's label.
+	// the CRD must be in the same namespace as the input
+	InputWatch_Label InputWatch_Mapping = 1
+	// reconcile the CRDs returned by a user-supplied mapper func.
+	// the func is scaffolded in <project root>/pkg/mappers
+	InputWatch_Custom InputWatch_Mapping = 2
+)
+
+var InputWatch_Mapping_name = map[int32]string{
+	0: "OwnerReference",
+	1: "Label",
+	2: "Custom",
+}
+
+var InputWatch_Mapping_value = map[string]int32{
+	"OwnerReference": 0,
+	"Label":          1,
+	"Custom":         2,
+}
+
+func (x InputWatch_Mapping) String() string {
+	return proto.EnumName(InputWatch_Mapping_name, int32(x))
+}
+
+func (InputWatch_Mapping) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f7c7e86e2b87635e, []int{4, 0}
+}
+
+// WriteStrategy determines how the scheduler writes outputs to the cluster
+type OutputConfig_WriteStrategy int32
+
+const (
+	// get the existing object and replace it with a full update, retrying on conflict.
+	// fields set by other controllers are overwritten
+	OutputConfig_Update OutputConfig_WriteStrategy = 0
+	// patch the object with server-side apply, using the Operator name as the field manager.
+	// only the fields set by the worker are owned by the Operator; fields set by other
+	// controllers (e.g. replicas managed by an HPA) are preserved
+	OutputConfig_Apply OutputConfig_WriteStrategy = 1
+)
+
+var OutputConfig_WriteStrategy_name = map[int32]string{
+	0: "Update",
+	1: "Apply",
+}
+
+var OutputConfig_WriteStrategy_value = map[string]int32{
+	"Update": 0,
+	"Apply":  1,
+}
+
+func (x OutputConfig_WriteStrategy) String() string {
+	return proto.EnumName(OutputConfig_WriteStrategy_name, int32(x))
+}
+
+func (OutputConfig_WriteStrategy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f7c7e86e2b87635e, []int{5, 0}
+}
+
 // The AutopilotProject file is the root configuration file for the project itself.
 //
 // This file will be used to build and deploy the autopilot operator.
@@ -49,10 +116,37 @@ type AutopilotProject struct {
 	// custom Parameters which extend Autopilot's builtin types
 	CustomParameters []*Parameter `protobuf:"bytes,6,rep,name=customParameters,proto3" json:"customParameters,omitempty"`
 	// custom Queries which extend Autopilot's metrics queries
-	Queries              []*MetricsQuery `protobuf:"bytes,7,rep,name=queries,proto3" json:"queries,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Queries []*MetricsQuery `protobuf:"bytes,7,rep,name=queries,proto3" json:"queries,omitempty"`
+	// admission webhooks to serve for the top-level CRD.
+	// when enabled, a user-owned webhooks package will be generated
+	// in <project root>/pkg/webhooks
+	Webhooks *Webhooks `protobuf:"bytes,8,opt,name=webhooks,proto3" json:"webhooks,omitempty"`
+	// the maximum number of phase transitions recorded in the phaseHistory
+	// of the top-level CRD's status. the oldest transitions are dropped first.
+	// defaults to 10
+	PhaseHistoryLimit uint32 `protobuf:"varint,9,opt,name=phaseHistoryLimit,proto3" json:"phaseHistoryLimit,omitempty"`
+	// the policy the scheduler applies when the spec of the top-level CRD changes
+	// (i.e. its metadata.generation no longer matches the observedGeneration in its status).
+	// one of:
+	// <br>
+	// `ignore`: keep the CRD in its current phase (default)
+	// <br>
+	// `restart`: move the CRD back to the initial phase
+	// <br>
+	// `phase:<Name>`: move the CRD to the named phase
+	// <br>
+	// the transition is performed even if the CRD is in a final phase,
+	// and skipped if the CRD is already in the target phase.
+	// spec changes made while the CRD is paused are applied once it is resumed,
+	// and spec changes made along with the force-phase annotation are applied after the phase is forced.
+	OnSpecChange string `protobuf:"bytes,10,opt,name=onSpecChange,proto3" json:"onSpecChange,omitempty"`
+	// input resources to watch in order to reconcile the top-level CRDs which read them
+	// as soon as the inputs change.
+	// inputs which are not watched are re-read on the next resync of the CRD
+	InputWatches         []*InputWatch `protobuf:"bytes,11,rep,name=inputWatches,proto3" json:"inputWatches,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *AutopilotProject) Reset()         { *m = AutopilotProject{} }
@@ -129,6 +223,34 @@ func (m *AutopilotProject) GetQueries() []*MetricsQuery {
 	return nil
 }
 
+func (m *AutopilotProject) GetWebhooks() *Webhooks {
+	if m != nil {
+		return m.Webhooks
+	}
+	return nil
+}
+
+func (m *AutopilotProject) GetPhaseHistoryLimit() uint32 {
+	if m != nil {
+		return m.PhaseHistoryLimit
+	}
+	return 0
+}
+
+func (m *AutopilotProject) GetOnSpecChange() string {
+	if m != nil {
+		return m.OnSpecChange
+	}
+	return ""
+}
+
+func (m *AutopilotProject) GetInputWatches() []*InputWatch {
+	if m != nil {
+		return m.InputWatches
+	}
+	return nil
+}
+
 // MeshProviders provide an interface to monitoring and managing a specific
 // mesh.
 //
@@ -159,10 +281,51 @@ type Phase struct {
 	//
 	// custom outputs can be defined in the
 	// autopilot.yaml
-	Outputs              []string `protobuf:"bytes,6,rep,name=outputs,proto3" json:"outputs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Outputs []string `protobuf:"bytes,6,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	// the names of the phases to which this phase may transition.
+	// remaining in the current phase is always permitted.
+	//
+	// if a phase declares no transitions, it may transition to any phase.
+	// final phases may not declare transitions.
+	//
+	// the generated scheduler will refuse (and log) any transition
+	// returned by a worker which is not declared here.
+	Transitions []string `protobuf:"bytes,7,rep,name=transitions,proto3" json:"transitions,omitempty"`
+	// the maximum amount of time the CRD may remain in this phase.
+	// once the timeout has elapsed (measured from the phaseEntryTime recorded in the CRD status),
+	// the scheduler will transition the CRD to the onTimeout phase without invoking the worker.
+	//
+	// if unset, the phase never times out.
+	// final phases may not declare a timeout.
+	Timeout *duration.Duration `protobuf:"bytes,8,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// the name of the phase to transition to when the timeout elapses.
+	// required if timeout is set.
+	OnTimeout string `protobuf:"bytes,9,opt,name=onTimeout,proto3" json:"onTimeout,omitempty"`
+	// the interval at which the CRD is resynced while in this phase.
+	// overrides the workInterval set in the autopilot-operator.yaml.
+	// workers may further override the interval for a single sync by returning a requeue interval from Sync.
+	//
+	// final phases are never resynced and may not declare a resyncInterval.
+	ResyncInterval *duration.Duration `protobuf:"bytes,10,opt,name=resyncInterval,proto3" json:"resyncInterval,omitempty"`
+	// the number of times the worker for this phase may fail consecutively
+	// before the scheduler transitions the CRD to the onError phase.
+	// requires onError to be set.
+	MaxRetries uint32 `protobuf:"varint,11,opt,name=maxRetries,proto3" json:"maxRetries,omitempty"`
+	// the backoff applied between consecutive worker failures in this phase.
+	// if unset, failed syncs are retried with the controller's default rate limiting.
+	Backoff *Backoff `protobuf:"bytes,12,opt,name=backoff,proto3" json:"backoff,omitempty"`
+	// the name of the phase to transition to once the worker
+	// has failed more than maxRetries times in a row.
+	// if unset, failing workers are retried indefinitely.
+	// final phases may not declare an error policy.
+	OnError string `protobuf:"bytes,13,opt,name=onError,proto3" json:"onError,omitempty"`
+	// configuration for the inputs of this phase, keyed by input name (e.g. `deployments`)
+	InputConfig map[string]*InputConfig `protobuf:"bytes,14,rep,name=inputConfig,proto3" json:"inputConfig,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// configuration for the outputs of this phase, keyed by output name (e.g. `virtualservices`)
+	OutputConfig         map[string]*OutputConfig `protobuf:"bytes,15,rep,name=outputConfig,proto3" json:"outputConfig,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
 func (m *Phase) Reset()         { *m = Phase{} }
@@ -232,6 +395,384 @@ func (m *Phase) GetOutputs() []string {
 	return nil
 }
 
+func (m *Phase) GetTransitions() []string {
+	if m != nil {
+		return m.Transitions
+	}
+	return nil
+}
+
+func (m *Phase) GetTimeout() *duration.Duration {
+	if m != nil {
+		return m.Timeout
+	}
+	return nil
+}
+
+func (m *Phase) GetOnTimeout() string {
+	if m != nil {
+		return m.OnTimeout
+	}
+	return ""
+}
+
+func (m *Phase) GetResyncInterval() *duration.Duration {
+	if m != nil {
+		return m.ResyncInterval
+	}
+	return nil
+}
+
+func (m *Phase) GetMaxRetries() uint32 {
+	if m != nil {
+		return m.MaxRetries
+	}
+	return 0
+}
+
+func (m *Phase) GetBackoff() *Backoff {
+	if m != nil {
+		return m.Backoff
+	}
+	return nil
+}
+
+func (m *Phase) GetOnError() string {
+	if m != nil {
+		return m.OnError
+	}
+	return ""
+}
+
+func (m *Phase) GetInputConfig() map[string]*InputConfig {
+	if m != nil {
+		return m.InputConfig
+	}
+	return nil
+}
+
+func (m *Phase) GetOutputConfig() map[string]*OutputConfig {
+	if m != nil {
+		return m.OutputConfig
+	}
+	return nil
+}
+
+// InputConfig configures how the scheduler retrieves an input for a phase
+type InputConfig struct {
+	// a label selector restricting the input objects passed to the worker.
+	// the selector is a Go text/template executed against the top-level CRD, e.g. `app={{ .Name }}`
+	LabelSelector string `protobuf:"bytes,1,opt,name=labelSelector,proto3" json:"labelSelector,omitempty"`
+	// a field selector restricting the input objects passed to the worker.
+	// the selector is a Go text/template executed against the top-level CRD, e.g. `metadata.name={{ .Spec.Target }}`.
+	// field selectors are served by the manager's cache, which only supports a single exact match on an indexed field.
+	// the scheduler indexes inputs by `metadata.name` and `metadata.ownerReferences.uid`;
+	// other field selectors are rejected when generating the operator
+	FieldSelector string `protobuf:"bytes,2,opt,name=fieldSelector,proto3" json:"fieldSelector,omitempty"`
+	// the namespace(s) in which the input is listed. one of:
+	// <br>
+	// `crNamespace`: the namespace of the top-level CRD
+	// <br>
+	// `controlPlane`: the controlPlaneNs set in the autopilot-operator.yaml
+	// <br>
+	// `all`: all namespaces
+	// <br>
+	// `namespace:<name>`: the named namespace
+	// <br>
+	// if unset, the input is listed in the namespace watched by the operator.
+	// the generated RBAC grants the operator read access to the input in the scoped namespace(s).
+	// a namespace-scoped operator only caches its watched namespace, so inputs scoped outside of it
+	// are read from the API server. such inputs cannot be selected by `metadata.ownerReferences.uid`,
+	// and their changes are not seen by input watches
+	Scope                string   `protobuf:"bytes,3,opt,name=scope,proto3" json:"scope,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InputConfig) Reset()         { *m = InputConfig{} }
+func (m *InputConfig) String() string { return proto.CompactTextString(m) }
+func (*InputConfig) ProtoMessage()    {}
+func (*InputConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7c7e86e2b87635e, []int{2}
+}
+
+func (m *InputConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InputConfig.Unmarshal(m, b)
+}
+func (m *InputConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InputConfig.Marshal(b, m, deterministic)
+}
+func (m *InputConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InputConfig.Merge(m, src)
+}
+func (m *InputConfig) XXX_Size() int {
+	return xxx_messageInfo_InputConfig.Size(m)
+}
+func (m *InputConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_InputConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InputConfig proto.InternalMessageInfo
+
+func (m *InputConfig) GetLabelSelector() string {
+	if m != nil {
+		return m.LabelSelector
+	}
+	return ""
+}
+
+func (m *InputConfig) GetFieldSelector() string {
+	if m != nil {
+		return m.FieldSelector
+	}
+	return ""
+}
+
+func (m *InputConfig) GetScope() string {
+	if m != nil {
+		return m.Scope
+	}
+	return ""
+}
+
+// Backoff configures the interval between retries of a failing worker.
+// the interval starts at the initialInterval and is multiplied by the multiplier
+// after each consecutive failure, up to the maxInterval.
+type Backoff struct {
+	// the interval to wait after the first failure. required.
+	InitialInterval *duration.Duration `protobuf:"bytes,1,opt,name=initialInterval,proto3" json:"initialInterval,omitempty"`
+	// the maximum interval to wait between retries.
+	// if unset, the interval is not capped
+	MaxInterval *duration.Duration `protobuf:"bytes,2,opt,name=maxInterval,proto3" json:"maxInterval,omitempty"`
+	// the factor by which the interval grows after each consecutive failure.
+	// use 1 for a constant backoff.
+	// defaults to 2
+	Multiplier           float64  `protobuf:"fixed64,3,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Backoff) Reset()         { *m = Backoff{} }
+func (m *Backoff) String() string { return proto.CompactTextString(m) }
+func (*Backoff) ProtoMessage()    {}
+func (*Backoff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7c7e86e2b87635e, []int{3}
+}
+
+func (m *Backoff) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Backoff.Unmarshal(m, b)
+}
+func (m *Backoff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Backoff.Marshal(b, m, deterministic)
+}
+func (m *Backoff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Backoff.Merge(m, src)
+}
+func (m *Backoff) XXX_Size() int {
+	return xxx_messageInfo_Backoff.Size(m)
+}
+func (m *Backoff) XXX_DiscardUnknown() {
+	xxx_messageInfo_Backoff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Backoff proto.InternalMessageInfo
+
+func (m *Backoff) GetInitialInterval() *duration.Duration {
+	if m != nil {
+		return m.InitialInterval
+	}
+	return nil
+}
+
+func (m *Backoff) GetMaxInterval() *duration.Duration {
+	if m != nil {
+		return m.MaxInterval
+	}
+	return nil
+}
+
+func (m *Backoff) GetMultiplier() float64 {
+	if m != nil {
+		return m.Multiplier
+	}
+	return 0
+}
+
+// InputWatch maps changes to an input resource to the top-level CRDs which should be reconciled
+type InputWatch struct {
+	// the name of the input to watch (e.g. `deployments`).
+	// must be an input of at least one phase
+	Input string `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	// how the changed input is mapped to top-level CRDs
+	Mapping InputWatch_Mapping `protobuf:"varint,2,opt,name=mapping,proto3,enum=autopilot.InputWatch_Mapping" json:"mapping,omitempty"`
+	// the label whose value is the name of the CRD to reconcile.
+	// required for the Label mapping
+	Label                string   `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InputWatch) Reset()         { *m = InputWatch{} }
+func (m *InputWatch) String() string { return proto.CompactTextString(m) }
+func (*InputWatch) ProtoMessage()    {}
+func (*InputWatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7c7e86e2b87635e, []int{4}
+}
+
+func (m *InputWatch) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InputWatch.Unmarshal(m, b)
+}
+func (m *InputWatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InputWatch.Marshal(b, m, deterministic)
+}
+func (m *InputWatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InputWatch.Merge(m, src)
+}
+func (m *InputWatch) XXX_Size() int {
+	return xxx_messageInfo_InputWatch.Size(m)
+}
+func (m *InputWatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_InputWatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InputWatch proto.InternalMessageInfo
+
+func (m *InputWatch) GetInput() string {
+	if m != nil {
+		return m.Input
+	}
+	return ""
+}
+
+func (m *InputWatch) GetMapping() InputWatch_Mapping {
+	if m != nil {
+		return m.Mapping
+	}
+	return InputWatch_OwnerReference
+}
+
+func (m *InputWatch) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+// OutputConfig configures how the scheduler writes an output for a phase
+type OutputConfig struct {
+	// when true, after each successful sync of the phase the scheduler deletes the objects of this type
+	// which are controlled by the top-level CRD (via its controller owner reference)
+	// but are no longer returned by the worker.
+	// note that a phase which returns an empty list of outputs of this type
+	// deletes every object of this type controlled by the top-level CRD
+	Prune bool `protobuf:"varint,1,opt,name=prune,proto3" json:"prune,omitempty"`
+	// how the scheduler writes objects of this type
+	WriteStrategy        OutputConfig_WriteStrategy `protobuf:"varint,2,opt,name=writeStrategy,proto3,enum=autopilot.OutputConfig_WriteStrategy" json:"writeStrategy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *OutputConfig) Reset()         { *m = OutputConfig{} }
+func (m *OutputConfig) String() string { return proto.CompactTextString(m) }
+func (*OutputConfig) ProtoMessage()    {}
+func (*OutputConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7c7e86e2b87635e, []int{5}
+}
+
+func (m *OutputConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OutputConfig.Unmarshal(m, b)
+}
+func (m *OutputConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OutputConfig.Marshal(b, m, deterministic)
+}
+func (m *OutputConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutputConfig.Merge(m, src)
+}
+func (m *OutputConfig) XXX_Size() int {
+	return xxx_messageInfo_OutputConfig.Size(m)
+}
+func (m *OutputConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutputConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutputConfig proto.InternalMessageInfo
+
+func (m *OutputConfig) GetPrune() bool {
+	if m != nil {
+		return m.Prune
+	}
+	return false
+}
+
+func (m *OutputConfig) GetWriteStrategy() OutputConfig_WriteStrategy {
+	if m != nil {
+		return m.WriteStrategy
+	}
+	return OutputConfig_Update
+}
+
+// Webhooks configure the admission webhooks served by the Operator
+// for its top-level CRD.
+//
+// The generated webhook handlers are registered with the Operator's manager,
+// and the ValidatingWebhookConfiguration/MutatingWebhookConfiguration, Service
+// and [cert-manager](https://cert-manager.io) Certificate
+// required to serve them are generated in <project root>/deploy
+type Webhooks struct {
+	// serve a validating admission webhook for the top-level CRD.
+	// validation is performed by the user-owned Validator in <project root>/pkg/webhooks/validator.go
+	Validating bool `protobuf:"varint,1,opt,name=validating,proto3" json:"validating,omitempty"`
+	// serve a mutating admission webhook for the top-level CRD.
+	// defaulting is performed by the user-owned Defaulter in <project root>/pkg/webhooks/defaulter.go
+	Mutating             bool     `protobuf:"varint,2,opt,name=mutating,proto3" json:"mutating,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Webhooks) Reset()         { *m = Webhooks{} }
+func (m *Webhooks) String() string { return proto.CompactTextString(m) }
+func (*Webhooks) ProtoMessage()    {}
+func (*Webhooks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7c7e86e2b87635e, []int{6}
+}
+
+func (m *Webhooks) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Webhooks.Unmarshal(m, b)
+}
+func (m *Webhooks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Webhooks.Marshal(b, m, deterministic)
+}
+func (m *Webhooks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Webhooks.Merge(m, src)
+}
+func (m *Webhooks) XXX_Size() int {
+	return xxx_messageInfo_Webhooks.Size(m)
+}
+func (m *Webhooks) XXX_DiscardUnknown() {
+	xxx_messageInfo_Webhooks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Webhooks proto.InternalMessageInfo
+
+func (m *Webhooks) GetValidating() bool {
+	if m != nil {
+		return m.Validating
+	}
+	return false
+}
+
+func (m *Webhooks) GetMutating() bool {
+	if m != nil {
+		return m.Mutating
+	}
+	return false
+}
+
 // Custom Parameters allow code to be generated
 // for inputs/outputs that are not built-in to Autopilot.
 // These types must be Kubernetes-compatible Go structs.
@@ -264,7 +805,7 @@ func (m *Parameter) Reset()         { *m = Parameter{} }
 func (m *Parameter) String() string { return proto.CompactTextString(m) }
 func (*Parameter) ProtoMessage()    {}
 func (*Parameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7c7e86e2b87635e, []int{2}
+	return fileDescriptor_f7c7e86e2b87635e, []int{7}
 }
 
 func (m *Parameter) XXX_Unmarshal(b []byte) error {
@@ -380,6 +921,9 @@ func (m *Parameter) GetIsCrd() bool {
 //     GetEnvoyRequestDuration(ctx context.Context, Namespace, Name, Interval string) (*metrics.QueryResult, error)
 // }
 // ```
+//
+// Each query also gets a range variant, e.g. `GetIstioSuccessRateRange(ctx, Namespace, Name, Interval string, start, end time.Time, step time.Duration)`,
+// which evaluates the query at every step between start and end.
 type MetricsQuery struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	QueryTemplate        string   `protobuf:"bytes,2,opt,name=queryTemplate,proto3" json:"queryTemplate,omitempty"`
@@ -393,7 +937,7 @@ func (m *MetricsQuery) Reset()         { *m = MetricsQuery{} }
 func (m *MetricsQuery) String() string { return proto.CompactTextString(m) }
 func (*MetricsQuery) ProtoMessage()    {}
 func (*MetricsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7c7e86e2b87635e, []int{3}
+	return fileDescriptor_f7c7e86e2b87635e, []int{8}
 }
 
 func (m *MetricsQuery) XXX_Unmarshal(b []byte) error {
@@ -436,8 +980,17 @@ func (m *MetricsQuery) GetParameters() []string {
 }
 
 func init() {
+	proto.RegisterEnum("autopilot.InputWatch_Mapping", InputWatch_Mapping_name, InputWatch_Mapping_value)
+	proto.RegisterEnum("autopilot.OutputConfig_WriteStrategy", OutputConfig_WriteStrategy_name, OutputConfig_WriteStrategy_value)
 	proto.RegisterType((*AutopilotProject)(nil), "autopilot.AutopilotProject")
 	proto.RegisterType((*Phase)(nil), "autopilot.Phase")
+	proto.RegisterMapType((map[string]*InputConfig)(nil), "autopilot.Phase.InputConfigEntry")
+	proto.RegisterMapType((map[string]*OutputConfig)(nil), "autopilot.Phase.OutputConfigEntry")
+	proto.RegisterType((*InputConfig)(nil), "autopilot.InputConfig")
+	proto.RegisterType((*Backoff)(nil), "autopilot.Backoff")
+	proto.RegisterType((*InputWatch)(nil), "autopilot.InputWatch")
+	proto.RegisterType((*OutputConfig)(nil), "autopilot.OutputConfig")
+	proto.RegisterType((*Webhooks)(nil), "autopilot.Webhooks")
 	proto.RegisterType((*Parameter)(nil), "autopilot.Parameter")
 	proto.RegisterType((*MetricsQuery)(nil), "autopilot.MetricsQuery")
 }
@@ -445,35 +998,72 @@ func init() {
 func init() { proto.RegisterFile("autopilot.proto", fileDescriptor_f7c7e86e2b87635e) }
 
 var fileDescriptor_f7c7e86e2b87635e = []byte{
-	// 467 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0xdb, 0x8e, 0xd3, 0x30,
-	0x10, 0x55, 0xb7, 0x6d, 0xda, 0xcc, 0x2e, 0xda, 0xca, 0x5a, 0x81, 0x85, 0x10, 0x8a, 0x02, 0x48,
-	0x79, 0xa1, 0xd5, 0xc2, 0x0f, 0x70, 0x91, 0xe0, 0x09, 0x54, 0x22, 0xc4, 0x03, 0x6f, 0x6e, 0x3a,
-	0xdb, 0x0e, 0xeb, 0xc4, 0xc6, 0x17, 0x60, 0xf9, 0x19, 0x3e, 0x82, 0xaf, 0xe1, 0x6f, 0x90, 0xdd,
-	0xa6, 0x49, 0x61, 0xdf, 0x7c, 0xce, 0x19, 0x8f, 0x66, 0xce, 0xb1, 0xe1, 0x5c, 0x78, 0xa7, 0x34,
-	0x49, 0xe5, 0xe6, 0xda, 0x28, 0xa7, 0x58, 0x7a, 0x20, 0xf2, 0xdf, 0x27, 0x30, 0x7b, 0xd9, 0xa2,
-	0xa5, 0x51, 0x5f, 0xb0, 0x72, 0x8c, 0xc1, 0xe8, 0x9a, 0x9a, 0x35, 0x1f, 0x64, 0x83, 0x22, 0x2d,
-	0xe3, 0x99, 0x3d, 0x04, 0x10, 0x9a, 0x3e, 0xa1, 0xb1, 0xa4, 0x1a, 0x7e, 0x12, 0x95, 0x1e, 0xc3,
-	0x72, 0x38, 0x53, 0x1a, 0x8d, 0x70, 0xca, 0xbc, 0x17, 0x35, 0xf2, 0x61, 0xac, 0x38, 0xe2, 0x58,
-	0x01, 0x89, 0xde, 0x0a, 0x8b, 0x96, 0x8f, 0xb2, 0x61, 0x71, 0xfa, 0x6c, 0x36, 0xef, 0x26, 0x5b,
-	0x06, 0xa1, 0xdc, 0xeb, 0xac, 0x80, 0x73, 0x6c, 0xc4, 0x4a, 0xe2, 0x1b, 0x6a, 0x84, 0xa4, 0x9f,
-	0x68, 0xf8, 0x38, 0x1b, 0x14, 0xd3, 0xf2, 0x5f, 0x9a, 0xbd, 0x80, 0x59, 0xe5, 0xad, 0x53, 0xf5,
-	0x52, 0x18, 0x51, 0xa3, 0x43, 0x63, 0x79, 0x12, 0xbb, 0x5f, 0xf4, 0xbb, 0xb7, 0x62, 0xf9, 0x5f,
-	0x35, 0xbb, 0x84, 0xc9, 0x57, 0x8f, 0x86, 0xd0, 0xf2, 0x49, 0xbc, 0x78, 0xaf, 0x77, 0xf1, 0x1d,
-	0x3a, 0x43, 0x95, 0xfd, 0xe0, 0xd1, 0xdc, 0x94, 0x6d, 0x5d, 0xfe, 0x6b, 0x00, 0xe3, 0x38, 0x70,
-	0xb0, 0xaa, 0x09, 0xeb, 0xee, 0xad, 0x0a, 0x67, 0x96, 0xc1, 0xe9, 0x1a, 0x6d, 0x65, 0x48, 0xbb,
-	0xce, 0xab, 0x3e, 0xc5, 0x38, 0x4c, 0xa8, 0x21, 0x47, 0x42, 0x46, 0x9f, 0xa6, 0x65, 0x0b, 0xd9,
-	0x05, 0x8c, 0xaf, 0xc2, 0x6e, 0x7c, 0x14, 0xf9, 0x1d, 0x60, 0x77, 0x21, 0xa1, 0x46, 0x7b, 0x67,
-	0xf9, 0x38, 0x1b, 0x16, 0x69, 0xb9, 0x47, 0xa1, 0x8f, 0xf2, 0x2e, 0x0a, 0x49, 0x14, 0x5a, 0x98,
-	0xff, 0x19, 0x40, 0x7a, 0xd8, 0x91, 0x3d, 0x80, 0x54, 0xaa, 0xef, 0xb8, 0x4b, 0x66, 0x37, 0x6a,
-	0x47, 0x84, 0x68, 0x2d, 0x35, 0x1b, 0x89, 0x51, 0xde, 0x47, 0xdb, 0x31, 0x41, 0xd7, 0xd2, 0x1b,
-	0x21, 0x7b, 0xc1, 0xf6, 0x98, 0x10, 0x3d, 0xd5, 0x5a, 0x19, 0xb7, 0x34, 0x78, 0x45, 0x3f, 0xe2,
-	0xe8, 0x69, 0x79, 0xc4, 0x85, 0x49, 0xb5, 0xa8, 0xae, 0xc5, 0x06, 0x63, 0x90, 0x69, 0xd9, 0x42,
-	0x76, 0x1f, 0xa6, 0x42, 0xd3, 0x5b, 0xa3, 0xbc, 0xe6, 0x49, 0x94, 0x0e, 0x38, 0xb8, 0x41, 0xf6,
-	0xb5, 0x59, 0xf3, 0xc9, 0xce, 0x8d, 0x08, 0xf2, 0x2d, 0x9c, 0xf5, 0x63, 0xb9, 0x35, 0x83, 0xc7,
-	0x70, 0x27, 0x84, 0x75, 0xf3, 0x11, 0x6b, 0x2d, 0x85, 0x6b, 0xd7, 0x3a, 0x26, 0xe3, 0x66, 0xdd,
-	0xb3, 0x19, 0x46, 0x0b, 0x7b, 0xcc, 0xab, 0x27, 0x9f, 0x1f, 0x6d, 0xc8, 0x6d, 0xfd, 0x6a, 0x5e,
-	0xa9, 0x7a, 0x61, 0x95, 0x54, 0x4f, 0x49, 0x2d, 0x0e, 0xaf, 0x63, 0x21, 0x34, 0x2d, 0xbe, 0x5d,
-	0xae, 0x92, 0xf8, 0xad, 0x9e, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xe9, 0x2a, 0x1e, 0x67, 0x69,
-	0x03, 0x00, 0x00,
+	// 1058 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x56, 0x6d, 0x73, 0x1b, 0xb5,
+	0x13, 0xff, 0x5f, 0x5c, 0x3f, 0xdc, 0xda, 0x4e, 0x5c, 0xfd, 0x4b, 0x39, 0x32, 0xd0, 0x31, 0x47,
+	0xcb, 0xf8, 0x45, 0x6b, 0x4f, 0x53, 0x66, 0x78, 0x7a, 0x43, 0x6a, 0x1a, 0xc8, 0xd0, 0xd2, 0xa0,
+	0x94, 0x86, 0xe1, 0x9d, 0x7c, 0x96, 0x6d, 0x11, 0x9d, 0x24, 0x74, 0xba, 0x24, 0xe6, 0x9b, 0xf0,
+	0x09, 0x78, 0xc1, 0x27, 0xe2, 0x63, 0xf0, 0x05, 0x18, 0x46, 0xba, 0x3b, 0x5b, 0x76, 0xc2, 0xe4,
+	0xdd, 0xed, 0x6f, 0x7f, 0xbb, 0x5a, 0xed, 0xae, 0x76, 0x0f, 0xf6, 0x48, 0x6e, 0xa4, 0x62, 0x5c,
+	0x9a, 0xa1, 0xd2, 0xd2, 0x48, 0x14, 0xae, 0x80, 0xfd, 0x07, 0x73, 0x29, 0xe7, 0x9c, 0x8e, 0x9c,
+	0x62, 0x92, 0xcf, 0x46, 0xd3, 0x5c, 0x13, 0xc3, 0xa4, 0x28, 0xa8, 0xf1, 0xdf, 0x35, 0xe8, 0x1d,
+	0x56, 0xec, 0x13, 0x2d, 0x7f, 0xa1, 0x89, 0x41, 0x08, 0xee, 0x9c, 0x33, 0x31, 0x8d, 0x82, 0x7e,
+	0x30, 0x08, 0xb1, 0xfb, 0x46, 0x0f, 0x00, 0x88, 0x62, 0x6f, 0xa9, 0xce, 0x98, 0x14, 0xd1, 0x8e,
+	0xd3, 0x78, 0x08, 0x8a, 0xa1, 0x23, 0x15, 0xd5, 0xc4, 0x48, 0xfd, 0x3d, 0x49, 0x69, 0x54, 0x73,
+	0x8c, 0x0d, 0x0c, 0x0d, 0xa0, 0xa1, 0x16, 0x24, 0xa3, 0x59, 0x74, 0xa7, 0x5f, 0x1b, 0xb4, 0x0f,
+	0x7a, 0xc3, 0x75, 0xe4, 0x27, 0x56, 0x81, 0x4b, 0x3d, 0x1a, 0xc0, 0x1e, 0x15, 0x64, 0xc2, 0xe9,
+	0x11, 0x13, 0x84, 0xb3, 0xdf, 0xa8, 0x8e, 0xea, 0xfd, 0x60, 0xd0, 0xc2, 0xdb, 0x30, 0xfa, 0x0a,
+	0x7a, 0x49, 0x9e, 0x19, 0x99, 0x9e, 0x10, 0x4d, 0x52, 0x6a, 0xa8, 0xce, 0xa2, 0x86, 0xf3, 0x7e,
+	0xcf, 0xf7, 0x5e, 0x29, 0xf1, 0x35, 0x36, 0x7a, 0x0a, 0xcd, 0x5f, 0x73, 0xaa, 0x19, 0xcd, 0xa2,
+	0xa6, 0x33, 0x7c, 0xd7, 0x33, 0x7c, 0x45, 0x8d, 0x66, 0x49, 0xf6, 0x43, 0x4e, 0xf5, 0x12, 0x57,
+	0x3c, 0x34, 0x82, 0xd6, 0x25, 0x9d, 0x2c, 0xa4, 0x3c, 0xcf, 0xa2, 0x56, 0x3f, 0x18, 0xb4, 0x0f,
+	0xfe, 0xef, 0xd9, 0x9c, 0x95, 0x2a, 0xbc, 0x22, 0xa1, 0xc7, 0x70, 0xd7, 0xdd, 0xec, 0x5b, 0x96,
+	0x19, 0xa9, 0x97, 0x2f, 0x59, 0xca, 0x4c, 0x14, 0xf6, 0x83, 0x41, 0x17, 0x5f, 0x57, 0xb8, 0x5c,
+	0x8a, 0x53, 0x45, 0x93, 0xf1, 0x82, 0x88, 0x39, 0x8d, 0xa0, 0xcc, 0xa5, 0x87, 0xa1, 0xcf, 0xa1,
+	0xc3, 0x84, 0xca, 0xcd, 0x19, 0x31, 0xc9, 0x82, 0x66, 0x51, 0xdb, 0x85, 0xfe, 0x8e, 0x17, 0xc6,
+	0xf1, 0x4a, 0x8d, 0x37, 0xa8, 0xf1, 0x3f, 0x75, 0xa8, 0xbb, 0x74, 0xdb, 0x42, 0x0b, 0x5b, 0xac,
+	0xb2, 0xd0, 0xf6, 0x1b, 0xf5, 0xa1, 0x3d, 0xa5, 0x59, 0xa2, 0x99, 0x32, 0xeb, 0x4a, 0xfb, 0x10,
+	0x8a, 0xa0, 0xc9, 0x04, 0x33, 0x8c, 0x70, 0x57, 0xe5, 0x16, 0xae, 0x44, 0x74, 0x0f, 0xea, 0x33,
+	0x5b, 0x99, 0xe8, 0x8e, 0xc3, 0x0b, 0x01, 0xdd, 0x87, 0x86, 0x3b, 0x3f, 0x8b, 0xea, 0xfd, 0xda,
+	0x20, 0xc4, 0xa5, 0x64, 0xfd, 0xc8, 0xdc, 0x38, 0x45, 0xc3, 0x29, 0x2a, 0xd1, 0xc6, 0x60, 0x34,
+	0x11, 0x19, 0xb3, 0xe7, 0x15, 0x65, 0x09, 0xb1, 0x0f, 0xa1, 0x67, 0xd0, 0x34, 0x2c, 0xa5, 0x32,
+	0x37, 0x65, 0x01, 0xde, 0x1b, 0x16, 0x9d, 0x3e, 0xac, 0x3a, 0x7d, 0xf8, 0x75, 0xd9, 0xe9, 0xb8,
+	0x62, 0xa2, 0xf7, 0x21, 0x94, 0xe2, 0x4d, 0x69, 0x16, 0xba, 0x8b, 0xad, 0x01, 0x74, 0x08, 0xbb,
+	0x9a, 0x66, 0x4b, 0x91, 0x1c, 0x0b, 0x43, 0xf5, 0x05, 0xe1, 0x11, 0xdc, 0xe6, 0x79, 0xcb, 0xc0,
+	0x3e, 0x92, 0x94, 0x5c, 0x61, 0xdb, 0x33, 0xae, 0x24, 0xb6, 0xbe, 0x1e, 0x82, 0x1e, 0x43, 0x73,
+	0x42, 0x92, 0x73, 0x39, 0x9b, 0x45, 0x1d, 0xe7, 0x1b, 0x79, 0xf5, 0x7a, 0x5e, 0x68, 0x70, 0x45,
+	0x71, 0xf9, 0x11, 0x2f, 0xb4, 0x96, 0x3a, 0xea, 0xba, 0x60, 0x2b, 0x11, 0x8d, 0xa1, 0xed, 0x72,
+	0x38, 0x96, 0x62, 0xc6, 0xe6, 0xd1, 0xae, 0xab, 0xfd, 0x87, 0xdb, 0xaf, 0x69, 0x78, 0xbc, 0xe6,
+	0xbc, 0x10, 0x46, 0x2f, 0xb1, 0x6f, 0x85, 0x8e, 0xa0, 0x53, 0xe4, 0xbb, 0xf4, 0xb2, 0xe7, 0xbc,
+	0xc4, 0xd7, 0xbc, 0xbc, 0xce, 0xcd, 0x96, 0x9b, 0x0d, 0xbb, 0xfd, 0xb7, 0xd0, 0xdb, 0x3e, 0x08,
+	0xf5, 0xa0, 0x76, 0x4e, 0x97, 0x65, 0x5f, 0xd9, 0x4f, 0xf4, 0x18, 0xea, 0x17, 0x84, 0xe7, 0xd4,
+	0x35, 0x54, 0xfb, 0xe0, 0xfe, 0x76, 0xa3, 0x16, 0xd6, 0xb8, 0x20, 0x7d, 0xb1, 0xf3, 0x59, 0xb0,
+	0xff, 0x13, 0xdc, 0xbd, 0x76, 0xf4, 0x0d, 0x8e, 0x9f, 0x6c, 0x3a, 0xf6, 0x1f, 0xaf, 0x6f, 0xee,
+	0x79, 0x8e, 0x25, 0xb4, 0xbd, 0x33, 0xd1, 0x43, 0xe8, 0x72, 0x32, 0xa1, 0xfc, 0x94, 0x72, 0x9a,
+	0x18, 0xa9, 0x4b, 0xef, 0x9b, 0xa0, 0x65, 0xcd, 0x18, 0xe5, 0xd3, 0x15, 0xab, 0x78, 0x19, 0x9b,
+	0xa0, 0x7d, 0x01, 0x59, 0x22, 0x55, 0x35, 0xff, 0x0a, 0x21, 0xfe, 0x33, 0x80, 0x66, 0x59, 0x5e,
+	0x34, 0x86, 0xbd, 0xf2, 0xb9, 0xac, 0xfa, 0x2c, 0xb8, 0xad, 0xcf, 0xb6, 0x2d, 0xd0, 0x97, 0xd0,
+	0x4e, 0xc9, 0xd5, 0xca, 0xc1, 0xce, 0x6d, 0x0e, 0x7c, 0xb6, 0xeb, 0xd2, 0x9c, 0x1b, 0xa6, 0x38,
+	0xa3, 0xda, 0x05, 0x1a, 0x60, 0x0f, 0x89, 0xff, 0x08, 0x00, 0xd6, 0xc3, 0xc3, 0x5e, 0xc9, 0xb5,
+	0x4d, 0x99, 0x96, 0x42, 0x40, 0x9f, 0x42, 0x33, 0x25, 0x4a, 0x31, 0x31, 0x77, 0xa7, 0xef, 0x1e,
+	0x7c, 0x70, 0xe3, 0xe8, 0x19, 0xbe, 0x2a, 0x48, 0xb8, 0x62, 0x5b, 0x77, 0x2e, 0xb1, 0x55, 0x86,
+	0x9c, 0x10, 0x7f, 0x02, 0xcd, 0x92, 0x89, 0x10, 0xec, 0xbe, 0xbe, 0x14, 0x54, 0x63, 0x3a, 0xa3,
+	0x9a, 0x8a, 0x84, 0xf6, 0xfe, 0x87, 0x42, 0xa8, 0xbf, 0xb4, 0xbc, 0x5e, 0x80, 0x00, 0x1a, 0x63,
+	0x37, 0xc2, 0x7b, 0x3b, 0xf1, 0xef, 0x01, 0x74, 0xfc, 0x22, 0x5b, 0xe7, 0x4a, 0xe7, 0xa2, 0x98,
+	0x68, 0x2d, 0x5c, 0x08, 0xe8, 0x3b, 0xe8, 0x5e, 0x6a, 0x66, 0xe8, 0xa9, 0xd1, 0xc4, 0xd0, 0xf9,
+	0xb2, 0x8c, 0xf8, 0xd1, 0x7f, 0xb4, 0xca, 0xf0, 0xcc, 0x27, 0xe3, 0x4d, 0xdb, 0xf8, 0x63, 0xe8,
+	0x6e, 0xe8, 0x6d, 0x40, 0x3f, 0xaa, 0x29, 0x31, 0x65, 0x9c, 0x87, 0x4a, 0xf1, 0x65, 0x2f, 0x88,
+	0x8f, 0xa0, 0x55, 0x2d, 0x02, 0x9b, 0xf1, 0x0b, 0xc2, 0xd9, 0x94, 0x18, 0x9b, 0xaf, 0x22, 0x36,
+	0x0f, 0x41, 0xfb, 0xd0, 0x4a, 0x73, 0x53, 0x68, 0x77, 0x9c, 0x76, 0x25, 0xc7, 0x7f, 0x05, 0x10,
+	0xae, 0xb6, 0x95, 0x1d, 0x61, 0x5c, 0x5e, 0xd2, 0x62, 0xc7, 0x16, 0x05, 0x59, 0x03, 0xf6, 0x9c,
+	0x8c, 0x89, 0x39, 0xa7, 0x4e, 0x5d, 0x2e, 0xe9, 0x35, 0x62, 0xf5, 0x8a, 0xe7, 0x9a, 0x70, 0x6f,
+	0x45, 0x7b, 0x88, 0x5d, 0x3c, 0x2c, 0x55, 0x52, 0x9b, 0x13, 0x4d, 0x67, 0xec, 0xca, 0x8d, 0xf1,
+	0x10, 0x6f, 0x60, 0x76, 0x2a, 0x29, 0x92, 0x9c, 0x93, 0x39, 0x75, 0x2b, 0x39, 0xc4, 0x95, 0x68,
+	0x6f, 0x41, 0x14, 0xfb, 0x46, 0xcb, 0x5c, 0x45, 0x0d, 0xa7, 0x5a, 0xc9, 0xae, 0x89, 0xb2, 0xb1,
+	0x9e, 0x46, 0xcd, 0xa2, 0x30, 0x4e, 0x88, 0x17, 0xd0, 0xf1, 0x17, 0xec, 0x8d, 0xfb, 0xe8, 0x21,
+	0x74, 0xed, 0xda, 0x5d, 0xbe, 0xa1, 0xa9, 0xe2, 0xc4, 0x54, 0xd7, 0xda, 0x04, 0xdd, 0xcd, 0xd6,
+	0x3f, 0x00, 0x35, 0xb7, 0x30, 0x3c, 0xe4, 0xf9, 0xa3, 0x9f, 0x3f, 0x9a, 0x33, 0xb3, 0xc8, 0x27,
+	0xc3, 0x44, 0xa6, 0xa3, 0x4c, 0x72, 0xf9, 0x84, 0xc9, 0xd1, 0xaa, 0xfe, 0x23, 0xa2, 0xd8, 0xe8,
+	0xe2, 0xe9, 0xa4, 0xe1, 0x9e, 0xce, 0xb3, 0x7f, 0x07, 0x00, 0xf2, 0x38, 0x61, 0x7a, 0x53, 0x09,
+	0x00, 0x00,
 }
@@ -62,7 +62,7 @@ func readAndReplaceManifest(file string) ([]byte, error) {
 	return replaceNamespace(replaceImage(ioutil.ReadFile(file)))
 }
 
-func getManifestsToApply(needsPrometheus bool) []string {
+func getManifestsToApply(needsPrometheus, needsValidatingWebhook, needsMutatingWebhook bool) []string {
 	manifestsToApply := []string{
 		"crd.yaml",
 		"configmap.yaml",
@@ -88,10 +88,29 @@ func getManifestsToApply(needsPrometheus bool) []string {
 		)
 	}
 
+	// webhook certificates require cert-manager to be installed in the cluster
+	if needsValidatingWebhook || needsMutatingWebhook {
+		manifestsToApply = append(manifestsToApply,
+			"webhook_service.yaml",
+			"webhook_issuer.yaml",
+			"webhook_certificate.yaml",
+		)
+	}
+	if needsValidatingWebhook {
+		manifestsToApply = append(manifestsToApply,
+			"validating_webhook_configuration.yaml",
+		)
+	}
+	if needsMutatingWebhook {
+		manifestsToApply = append(manifestsToApply,
+			"mutating_webhook_configuration.yaml",
+		)
+	}
+
 	return manifestsToApply
 }
 
-func deploy(operatorName string, needsPrometheus bool) error {
+func deploy(operatorName string, needsPrometheus, needsValidatingWebhook, needsMutatingWebhook bool, inputRBACManifests []string) error {
 
 	if push {
 		log.Printf("Pushing image %v", image)
@@ -111,7 +130,7 @@ func deploy(operatorName string, needsPrometheus bool) error {
 		}
 	}
 
-	for _, man := range getManifestsToApply(needsPrometheus) {
+	for _, man := range getManifestsToApply(needsPrometheus, needsValidatingWebhook, needsMutatingWebhook) {
 		log.Printf("Deploying %v", man)
 
 		raw, err := readAndReplaceManifest(filepath.Join("deploy", man))
@@ -123,6 +142,22 @@ func deploy(operatorName string, needsPrometheus bool) error {
 		}
 	}
 
+	// the input rbac manifests declare their own namespaces
+	// and are only required when the operator is namespace-scoped
+	if !clusterScoped {
+		for _, man := range inputRBACManifests {
+			log.Printf("Deploying %v", man)
+
+			raw, err := readAndReplaceManifest(filepath.Join("deploy", man))
+			if err != nil {
+				return err
+			}
+			if err := utils.KubectlApply(raw); err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -143,7 +178,7 @@ func deployFunc(cmd *cobra.Command, args []string) error {
 
 	log.Infof("Deploying Operator with image %s", image)
 
-	if err := deploy(cfg.OperatorName, cfg.NeedsPrometheus()); err != nil {
+	if err := deploy(cfg.OperatorName, cfg.NeedsPrometheus(), cfg.NeedsValidatingWebhook(), cfg.NeedsMutatingWebhook(), codegen.InputRBACManifests(cfg)); err != nil {
 		return fmt.Errorf("failed to deploy operator with image %s: (%v)", image, err)
 	}
 
@@ -160,6 +160,7 @@ func projectFiles(data *model.ProjectData) []*GenFile {
 		// user should regenerate after changing autopilot.yaml or spec.go
 		{OutPath: filepath.Join(typesRelativePath, "doc.go"), TemplatePath: "code/doc.gotmpl"},
 		{OutPath: filepath.Join(typesRelativePath, "phases.go"), TemplatePath: "code/phases.gotmpl"},
+		{OutPath: filepath.Join(typesRelativePath, "status.go"), TemplatePath: "code/status.gotmpl"},
 		{OutPath: filepath.Join(typesRelativePath, "register.go"), TemplatePath: "code/register.gotmpl"},
 		{OutPath: filepath.Join(typesRelativePath, "spec.go"), TemplatePath: "code/spec.gotmpl", SkipOverwrite: true},
 		{OutPath: filepath.Join(typesRelativePath, "types.go"), TemplatePath: "code/types.gotmpl"},
@@ -200,6 +201,44 @@ func projectFiles(data *model.ProjectData) []*GenFile {
 			OutPath: filepath.Join(model.MetricsRelativePath, "metrics.go"), TemplatePath: "code/metrics.gotmpl"})
 	}
 
+	if data.NeedsWebhooks() {
+		files = append(files,
+			&GenFile{OutPath: filepath.Join(model.WebhooksRelativePath, "webhooks.go"), TemplatePath: "code/webhooks.gotmpl"},
+			&GenFile{OutPath: filepath.Join("deploy", "webhook_service.yaml"), TemplateFunc: deploy.WebhookService},
+			&GenFile{OutPath: filepath.Join("deploy", "webhook_issuer.yaml"), TemplateFunc: deploy.WebhookIssuer},
+			&GenFile{OutPath: filepath.Join("deploy", "webhook_certificate.yaml"), TemplateFunc: deploy.WebhookCertificate},
+		)
+	}
+
+	if data.NeedsValidatingWebhook() {
+		files = append(files,
+			&GenFile{OutPath: filepath.Join(model.WebhooksRelativePath, "validator.go"), TemplatePath: "code/validator.gotmpl", SkipOverwrite: true},
+			&GenFile{OutPath: filepath.Join("deploy", "validating_webhook_configuration.yaml"), TemplateFunc: deploy.ValidatingWebhookConfiguration},
+		)
+	}
+
+	if data.NeedsMutatingWebhook() {
+		files = append(files,
+			&GenFile{OutPath: filepath.Join(model.WebhooksRelativePath, "defaulter.go"), TemplatePath: "code/defaulter.gotmpl", SkipOverwrite: true},
+			&GenFile{OutPath: filepath.Join("deploy", "mutating_webhook_configuration.yaml"), TemplateFunc: deploy.MutatingWebhookConfiguration},
+		)
+	}
+
+	// rbac for inputs scoped outside of the operator's namespace, and for Prometheus discovery
+	// only required by namespace-scoped operators
+	for _, ns := range data.ExternalInputNamespaces() {
+		files = append(files,
+			&GenFile{OutPath: filepath.Join("deploy", inputRoleManifest(ns)), TemplateFunc: deploy.InputRole(ns)},
+			&GenFile{OutPath: filepath.Join("deploy", inputRoleBindingManifest(ns)), TemplateFunc: deploy.InputRoleBinding(ns)},
+		)
+	}
+	if data.HasClusterWideInputs() {
+		files = append(files,
+			&GenFile{OutPath: filepath.Join("deploy", inputClusterRoleManifest), TemplateFunc: deploy.InputClusterRole},
+			&GenFile{OutPath: filepath.Join("deploy", inputClusterRoleBindingManifest), TemplateFunc: deploy.InputClusterRoleBinding},
+		)
+	}
+
 	if data.NeedsPrometheus() {
 		files = append(files, &GenFile{
 			OutPath: filepath.Join("deploy", "prometheus.yaml"), TemplatePath: "deploy/prometheus.yamltmpl",
@@ -209,16 +248,58 @@ func projectFiles(data *model.ProjectData) []*GenFile {
 	return files
 }
 
+const (
+	inputClusterRoleManifest        = "clusterrole-inputs.yaml"
+	inputClusterRoleBindingManifest = "clusterrolebinding-inputs.yaml"
+)
+
+func inputRoleManifest(namespace string) string {
+	return "role-inputs-" + namespace + ".yaml"
+}
+
+func inputRoleBindingManifest(namespace string) string {
+	return "rolebinding-inputs-" + namespace + ".yaml"
+}
+
+// InputRBACManifests returns the manifests in the deploy directory which grant
+// a namespace-scoped operator access to inputs scoped outside of its namespace
+func InputRBACManifests(data *model.ProjectData) []string {
+	var manifests []string
+	for _, ns := range data.ExternalInputNamespaces() {
+		manifests = append(manifests, inputRoleManifest(ns), inputRoleBindingManifest(ns))
+	}
+	if data.HasClusterWideInputs() {
+		manifests = append(manifests, inputClusterRoleManifest, inputClusterRoleBindingManifest)
+	}
+	return manifests
+}
+
 // phaseFiles returns files for each worker
 func phaseFiles(phase model.Phase) []*GenFile {
 	return []*GenFile{
-		// worker io file
+		// worker struct and io file
 		// user should regenerate after changing autopilot.yaml
 		{OutPath: filepath.Join("pkg", "workers", model.WorkerDirName(phase), "inputs_outputs.go"), TemplatePath: "code/inputs_outputs.gotmpl"},
 
 		// worker file
 		// user should modify
 		{OutPath: filepath.Join("pkg", "workers", model.WorkerDirName(phase), "worker.go"), TemplatePath: "code/worker.gotmpl", SkipOverwrite: true},
+
+		// worker test file
+		// user should modify
+		{OutPath: filepath.Join("pkg", "workers", model.WorkerDirName(phase), "worker_test.go"), TemplatePath: "code/worker_test.gotmpl", SkipOverwrite: true},
+	}
+}
+
+// inputWatchFiles returns files for each input watch
+func inputWatchFiles(watch model.InputWatch) []*GenFile {
+	if !watch.ByMapper() {
+		return nil
+	}
+	return []*GenFile{
+		// mapper file
+		// user should modify
+		{OutPath: filepath.Join(model.MappersRelativePath, watch.Param.LowerName+".go"), TemplatePath: "code/mapper.gotmpl", SkipOverwrite: true},
 	}
 }
 
@@ -253,6 +334,17 @@ func Generate(data *model.ProjectData) ([]*GenFile, error) {
 		}
 	}
 
+	for _, watch := range data.InputWatches {
+		for _, watchFile := range inputWatchFiles(watch) {
+			contents, err := renderFile(data, watch, watchFile.TemplatePath)
+			if err != nil {
+				return nil, err
+			}
+			watchFile.Content = contents
+			files = append(files, watchFile)
+		}
+	}
+
 	// prepend the generated header to generated files
 	for _, f := range files {
 		if f.SkipOverwrite {
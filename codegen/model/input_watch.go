@@ -0,0 +1,76 @@
+package model
+
+import (
+	"github.com/pkg/errors"
+	v1 "github.com/solo-io/autopilot/api/v1"
+)
+
+// this is the internal representation of the InputWatches written by the user
+// the input watch replaces the input string with the actual Parameter type
+type InputWatch struct {
+	v1.InputWatch
+
+	// internal representation of the watched input
+	Param Parameter
+
+	// set by load
+	Project *ProjectData `json:"-"`
+}
+
+// the watch enqueues the controller owner of the changed input
+func (w InputWatch) ByOwnerReference() bool {
+	return w.Mapping == v1.InputWatch_OwnerReference
+}
+
+// the watch enqueues the CRD named by the label of the changed input
+func (w InputWatch) ByLabel() bool {
+	return w.Mapping == v1.InputWatch_Label
+}
+
+// the watch enqueues the CRDs returned by the user-supplied mapper
+func (w InputWatch) ByMapper() bool {
+	return w.Mapping == v1.InputWatch_Custom
+}
+
+// the name of the user-supplied mapper func for the watch
+func (w InputWatch) MapperFunc() string {
+	return "Map" + w.Param.PluralName
+}
+
+func validateInputWatches(data *ProjectData) error {
+	watched := map[string]bool{}
+	for _, watch := range data.InputWatches {
+		name := watch.Input
+		if watched[name] {
+			return errors.Errorf("input %v is watched more than once", name)
+		}
+		watched[name] = true
+
+		if watch.Param.Equals(Metrics) {
+			return errors.Errorf("input %v cannot be watched", name)
+		}
+		var isInput bool
+		for _, phase := range data.Phases {
+			for _, in := range phase.Inputs {
+				if in.Equals(watch.Param) {
+					isInput = true
+				}
+			}
+		}
+		if !isInput {
+			return errors.Errorf("watched input %v is not an input of any phase", name)
+		}
+
+		if watch.ByOwnerReference() && data.isOutput(watch.Param) {
+			return errors.Errorf("input %v is an output, and outputs are already watched by owner reference", name)
+		}
+
+		switch {
+		case watch.ByLabel() && watch.Label == "":
+			return errors.Errorf("input watch %v requires a label for the Label mapping", name)
+		case !watch.ByLabel() && watch.Label != "":
+			return errors.Errorf("input watch %v declares a label without the Label mapping", name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package model_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/autopilot/api/v1"
+	. "github.com/solo-io/autopilot/codegen/model"
+)
+
+var _ = Describe("Input watches", func() {
+	var data *ProjectData
+	watch := func(param Parameter, mapping v1.InputWatch_Mapping, label string) InputWatch {
+		return InputWatch{
+			InputWatch: v1.InputWatch{Input: param.LowerName, Mapping: mapping, Label: label},
+			Param:      param,
+		}
+	}
+	BeforeEach(func() {
+		data = &ProjectData{Phases: []Phase{{
+			Phase:   v1.Phase{Name: "Initializing", Initial: true},
+			Inputs:  []Parameter{Deployments, Services},
+			Outputs: []Parameter{Deployments},
+		}}}
+	})
+
+	It("rejects owner reference watches of inputs which are also outputs", func() {
+		data.InputWatches = []InputWatch{watch(Deployments, v1.InputWatch_OwnerReference, "")}
+		Expect(data.Validate()).To(MatchError("input deployments is an output, and outputs are already watched by owner reference"))
+	})
+	It("accepts other mappings for inputs which are also outputs", func() {
+		data.InputWatches = []InputWatch{
+			watch(Deployments, v1.InputWatch_Label, "app"),
+			watch(Services, v1.InputWatch_OwnerReference, ""),
+		}
+		Expect(data.Validate()).NotTo(HaveOccurred())
+	})
+	It("requires watched inputs to be phase inputs", func() {
+		data.InputWatches = []InputWatch{watch(Pods, v1.InputWatch_Custom, "")}
+		Expect(data.Validate()).To(MatchError("watched input pods is not an input of any phase"))
+	})
+	It("requires a label only for the Label mapping", func() {
+		data.InputWatches = []InputWatch{watch(Services, v1.InputWatch_Label, "")}
+		Expect(data.Validate()).To(MatchError("input watch services requires a label for the Label mapping"))
+		data.InputWatches = []InputWatch{watch(Services, v1.InputWatch_Custom, "app")}
+		Expect(data.Validate()).To(MatchError("input watch services declares a label without the Label mapping"))
+	})
+})
@@ -25,4 +25,10 @@ var (
 
 	// function for determining the relative path of generated metrics package
 	MetricsRelativePath = "pkg/metrics"
+
+	// function for determining the relative path of generated webhooks package
+	WebhooksRelativePath = "pkg/webhooks"
+
+	// function for determining the relative path of generated mappers package
+	MappersRelativePath = "pkg/mappers"
 )
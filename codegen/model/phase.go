@@ -1,9 +1,18 @@
 package model
 
 import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/duration"
+	"github.com/iancoleman/strcase"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	v1 "github.com/solo-io/autopilot/api/v1"
+	"github.com/solo-io/autopilot/pkg/config"
+	"github.com/solo-io/autopilot/pkg/scheduler"
 )
 
 // this is the internal representation of the Phases written by the user
@@ -38,6 +47,140 @@ func ModelPhase(data *ProjectData, phase *v1.Phase) (Phase, error) {
 	}, nil
 }
 
+// the timeout for the phase, 0 if the phase never times out
+func (p Phase) TimeoutDuration() time.Duration {
+	return durationOrZero(p.Timeout)
+}
+
+// the resync interval for the phase, 0 if the phase uses the operator's workInterval
+func (p Phase) ResyncIntervalDuration() time.Duration {
+	return durationOrZero(p.ResyncInterval)
+}
+
+// the interval to wait after the first consecutive worker failure in the phase
+func (p Phase) BackoffInitialInterval() time.Duration {
+	return durationOrZero(p.GetBackoff().GetInitialInterval())
+}
+
+// the maximum interval to wait between worker retries in the phase, 0 if uncapped
+func (p Phase) BackoffMaxInterval() time.Duration {
+	return durationOrZero(p.GetBackoff().GetMaxInterval())
+}
+
+// the factor by which the backoff interval grows after each consecutive failure
+func (p Phase) BackoffMultiplier() float64 {
+	if multiplier := p.GetBackoff().GetMultiplier(); multiplier != 0 {
+		return multiplier
+	}
+	return 2
+}
+
+// the configuration of the given output for the phase, nil if the output is not configured
+func (p Phase) OutputConfigFor(param Parameter) *v1.OutputConfig {
+	return p.OutputConfig[param.LowerName]
+}
+
+// true if the phase prunes the objects of the given output which the worker no longer returns
+func (p Phase) PruneOutput(param Parameter) bool {
+	return p.OutputConfigFor(param).GetPrune()
+}
+
+// true if the phase writes the given output with server-side apply rather than a full update
+func (p Phase) ApplyOutput(param Parameter) bool {
+	return p.OutputConfigFor(param).GetWriteStrategy() == v1.OutputConfig_Apply
+}
+
+// the phase expected after a sync in the generated worker test:
+// the first declared transition, or the phase itself
+func (p Phase) ExampleNextPhase() string {
+	if len(p.Transitions) > 0 {
+		return p.Transitions[0]
+	}
+	return p.Name
+}
+
+// true if the phase has inputs other than metrics, which are indexed for lookups
+func (p Phase) HasIndexedInputs() bool {
+	for _, in := range p.Inputs {
+		if !in.Equals(Metrics) {
+			return true
+		}
+	}
+	return false
+}
+
+// the configuration of the given input for the phase, nil if the input is not configured
+func (p Phase) InputConfigFor(param Parameter) *v1.InputConfig {
+	return p.InputConfig[param.LowerName]
+}
+
+// true if the listed objects of the input are restricted by a selector
+func (p Phase) HasInputSelector(param Parameter) bool {
+	cfg := p.InputConfigFor(param)
+	return cfg.GetLabelSelector() != "" || cfg.GetFieldSelector() != ""
+}
+
+// the name of the generated variable holding the selector for the input
+func (p Phase) InputSelectorVar(param Parameter) string {
+	return strcase.ToLowerCamel(p.Name) + param.PluralName + "Selector"
+}
+
+const (
+	InputScopeCrNamespace     = "crNamespace"
+	InputScopeControlPlane    = "controlPlane"
+	InputScopeAll             = "all"
+	inputScopeNamespacePrefix = "namespace:"
+)
+
+// the Go expression for the namespace in which the generated scheduler lists the input
+func (p Phase) InputNamespaceExpr(param Parameter) string {
+	switch scope := p.InputConfigFor(param).GetScope(); {
+	case scope == InputScopeCrNamespace:
+		return p.Project.KindLowerCamel + ".Namespace"
+	case scope == InputScopeControlPlane:
+		return "s.controlPlaneNs"
+	case scope == InputScopeAll:
+		return "metav1.NamespaceAll"
+	case strings.HasPrefix(scope, inputScopeNamespacePrefix):
+		return strconv.Quote(strings.TrimPrefix(scope, inputScopeNamespacePrefix))
+	default:
+		return "s.namespace"
+	}
+}
+
+// the namespace outside of the namespace watched by the operator in which the input is listed.
+// the namespace is empty for inputs listed in all namespaces.
+// returns false for inputs listed in the watched namespace or in the namespace of the top-level CRD
+func (p Phase) ExternalInputNamespace(param Parameter) (string, bool) {
+	switch scope := p.InputConfigFor(param).GetScope(); {
+	case scope == InputScopeControlPlane:
+		return config.ControlPlaneNamespace(&p.Project.AutopilotOperator), true
+	case scope == InputScopeAll:
+		return "", true
+	case strings.HasPrefix(scope, inputScopeNamespacePrefix):
+		return strings.TrimPrefix(scope, inputScopeNamespacePrefix), true
+	default:
+		return "", false
+	}
+}
+
+// true if the input is listed outside of the namespace watched by the operator
+func (p Phase) IsExternalInput(param Parameter) bool {
+	_, external := p.ExternalInputNamespace(param)
+	return external
+}
+
+func durationOrZero(pd *duration.Duration) time.Duration {
+	if pd == nil {
+		return 0
+	}
+	d, err := ptypes.Duration(pd)
+	if err != nil {
+		return 0
+	}
+	return d
+}
+
 func MustPhase(data *ProjectData, phase *v1.Phase) Phase {
 	p, err := ModelPhase(data, phase)
 	if err != nil {
@@ -66,3 +209,235 @@ func paramsFromNames(names []string) ([]Parameter, error) {
 	}
 	return params, nil
 }
+
+// validate that the phases form a consistent graph:
+// phase names must be unique, exactly one phase must be initial,
+// declared transitions and timeouts must refer to known phases and
+// (if any transitions are declared) every phase must be reachable from the initial phase
+func validatePhaseGraph(phases []Phase) error {
+	phasesByName := map[string]Phase{}
+	var initial []string
+	for _, phase := range phases {
+		if phase.Name == "" {
+			return errors.Errorf("phase names cannot be empty")
+		}
+		if _, exists := phasesByName[phase.Name]; exists {
+			return errors.Errorf("phase %v is declared more than once", phase.Name)
+		}
+		phasesByName[phase.Name] = phase
+		if phase.Initial {
+			initial = append(initial, phase.Name)
+		}
+	}
+	if len(initial) != 1 {
+		return errors.Errorf("exactly one phase must be initial, found %v", initial)
+	}
+
+	for _, phase := range phases {
+		if err := validatePhaseTimeout(phase, phasesByName); err != nil {
+			return err
+		}
+		if err := validatePhaseResyncInterval(phase); err != nil {
+			return err
+		}
+		if err := validatePhaseErrorPolicy(phase, phasesByName); err != nil {
+			return err
+		}
+		if err := validatePhaseInputConfig(phase); err != nil {
+			return err
+		}
+		if err := validatePhaseOutputConfig(phase); err != nil {
+			return err
+		}
+	}
+
+	var declaresTransitions bool
+	for _, phase := range phases {
+		if len(phase.Transitions) == 0 {
+			continue
+		}
+		declaresTransitions = true
+		if phase.Final {
+			return errors.Errorf("final phase %v cannot declare transitions", phase.Name)
+		}
+		for _, next := range phase.Transitions {
+			if _, ok := phasesByName[next]; !ok {
+				return errors.Errorf("phase %v declares transition to unknown phase %v", phase.Name, next)
+			}
+		}
+	}
+	if !declaresTransitions {
+		return nil
+	}
+
+	// walk the graph from the initial phase.
+	// a non-final phase without declared transitions may transition to any phase.
+	// the scheduler may always transition a phase to its onTimeout and onError phases
+	reached := map[string]bool{}
+	var visit func(name string)
+	visit = func(name string) {
+		if reached[name] {
+			return
+		}
+		reached[name] = true
+		phase := phasesByName[name]
+		switch {
+		case phase.Final:
+		case len(phase.Transitions) == 0:
+			for _, next := range phases {
+				visit(next.Name)
+			}
+		default:
+			for _, next := range phase.Transitions {
+				visit(next)
+			}
+		}
+		if phase.OnTimeout != "" {
+			visit(phase.OnTimeout)
+		}
+		if phase.OnError != "" {
+			visit(phase.OnError)
+		}
+	}
+	visit(initial[0])
+
+	for _, phase := range phases {
+		if !reached[phase.Name] {
+			return errors.Errorf("phase %v is unreachable from initial phase %v", phase.Name, initial[0])
+		}
+	}
+	return nil
+}
+
+func validatePhaseTimeout(phase Phase, phasesByName map[string]Phase) error {
+	if phase.Timeout == nil {
+		if phase.OnTimeout != "" {
+			return errors.Errorf("phase %v declares onTimeout without a timeout", phase.Name)
+		}
+		return nil
+	}
+	if phase.Final {
+		return errors.Errorf("final phase %v cannot declare a timeout", phase.Name)
+	}
+	timeout, err := ptypes.Duration(phase.Timeout)
+	if err != nil {
+		return errors.Wrapf(err, "phase %v timeout", phase.Name)
+	}
+	if timeout <= 0 {
+		return errors.Errorf("phase %v timeout must be positive", phase.Name)
+	}
+	if phase.OnTimeout == "" {
+		return errors.Errorf("phase %v declares a timeout without onTimeout", phase.Name)
+	}
+	if _, ok := phasesByName[phase.OnTimeout]; !ok {
+		return errors.Errorf("phase %v declares onTimeout to unknown phase %v", phase.Name, phase.OnTimeout)
+	}
+	return nil
+}
+
+func validatePhaseResyncInterval(phase Phase) error {
+	if phase.ResyncInterval == nil {
+		return nil
+	}
+	if phase.Final {
+		return errors.Errorf("final phase %v cannot declare a resyncInterval", phase.Name)
+	}
+	interval, err := ptypes.Duration(phase.ResyncInterval)
+	if err != nil {
+		return errors.Wrapf(err, "phase %v resyncInterval", phase.Name)
+	}
+	if interval <= 0 {
+		return errors.Errorf("phase %v resyncInterval must be positive", phase.Name)
+	}
+	return nil
+}
+
+func validatePhaseErrorPolicy(phase Phase, phasesByName map[string]Phase) error {
+	if phase.MaxRetries == 0 && phase.Backoff == nil && phase.OnError == "" {
+		return nil
+	}
+	if phase.Final {
+		return errors.Errorf("final phase %v cannot declare an error policy", phase.Name)
+	}
+	if phase.OnError == "" {
+		if phase.MaxRetries > 0 {
+			return errors.Errorf("phase %v declares maxRetries without onError", phase.Name)
+		}
+	} else if _, ok := phasesByName[phase.OnError]; !ok {
+		return errors.Errorf("phase %v declares onError to unknown phase %v", phase.Name, phase.OnError)
+	}
+	if phase.Backoff == nil {
+		return nil
+	}
+	initial, err := ptypes.Duration(phase.Backoff.GetInitialInterval())
+	if err != nil {
+		return errors.Wrapf(err, "phase %v backoff initialInterval", phase.Name)
+	}
+	if initial <= 0 {
+		return errors.Errorf("phase %v backoff initialInterval must be positive", phase.Name)
+	}
+	if phase.Backoff.MaxInterval != nil {
+		max, err := ptypes.Duration(phase.Backoff.MaxInterval)
+		if err != nil {
+			return errors.Wrapf(err, "phase %v backoff maxInterval", phase.Name)
+		}
+		if max < initial {
+			return errors.Errorf("phase %v backoff maxInterval must not be less than initialInterval", phase.Name)
+		}
+	}
+	if phase.Backoff.Multiplier != 0 && phase.Backoff.Multiplier < 1 {
+		return errors.Errorf("phase %v backoff multiplier must be at least 1", phase.Name)
+	}
+	return nil
+}
+
+func validatePhaseInputConfig(phase Phase) error {
+	for name, cfg := range phase.InputConfig {
+		var isInput bool
+		for _, in := range phase.Inputs {
+			if in.LowerName == name {
+				isInput = true
+			}
+		}
+		if !isInput {
+			return errors.Errorf("phase %v configures unknown input %v", phase.Name, name)
+		}
+		if name == Metrics.LowerName && (cfg.GetLabelSelector() != "" || cfg.GetFieldSelector() != "" || cfg.GetScope() != "") {
+			return errors.Errorf("phase %v cannot configure input %v", phase.Name, name)
+		}
+		switch scope := cfg.GetScope(); {
+		case scope == "", scope == InputScopeCrNamespace, scope == InputScopeControlPlane, scope == InputScopeAll:
+		case strings.HasPrefix(scope, inputScopeNamespacePrefix) && scope != inputScopeNamespacePrefix:
+		default:
+			return errors.Errorf("phase %v input %v has invalid scope %q: must be one of %v, %v, %v, or %v<name>",
+				phase.Name, name, scope, InputScopeCrNamespace, InputScopeControlPlane, InputScopeAll, inputScopeNamespacePrefix)
+		}
+		if _, err := scheduler.ParseSelector(cfg.GetLabelSelector(), cfg.GetFieldSelector()); err != nil {
+			return errors.Wrapf(err, "phase %v input %v", phase.Name, name)
+		}
+		if err := scheduler.ValidateFieldSelector(cfg.GetFieldSelector()); err != nil {
+			return errors.Wrapf(err, "phase %v input %v", phase.Name, name)
+		}
+		// inputs outside of the namespace watched by the operator are not read from the cache, which holds the indexes
+		external := cfg.GetScope() != "" && cfg.GetScope() != InputScopeCrNamespace
+		if external && strings.HasPrefix(strings.TrimSpace(cfg.GetFieldSelector()), scheduler.OwnerField) {
+			return errors.Errorf("phase %v input %v cannot select by %v outside of the watched namespace", phase.Name, name, scheduler.OwnerField)
+		}
+	}
+	return nil
+}
+
+func validatePhaseOutputConfig(phase Phase) error {
+	for name := range phase.OutputConfig {
+		var isOutput bool
+		for _, out := range phase.Outputs {
+			if out.LowerName == name {
+				isOutput = true
+			}
+		}
+		if !isOutput {
+			return errors.Errorf("phase %v configures unknown output %v", phase.Name, name)
+		}
+	}
+	return nil
+}
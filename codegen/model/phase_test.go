@@ -0,0 +1,203 @@
+package model_test
+
+import (
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/autopilot/api/v1"
+	. "github.com/solo-io/autopilot/codegen/model"
+)
+
+var _ = Describe("Phase graph validation", func() {
+	phase := func(name string, initial, final bool, transitions ...string) Phase {
+		return Phase{Phase: v1.Phase{
+			Name:        name,
+			Initial:     initial,
+			Final:       final,
+			Transitions: transitions,
+		}}
+	}
+	validate := func(phases ...Phase) error {
+		return (&ProjectData{Phases: phases}).Validate()
+	}
+
+	It("accepts phases which declare no transitions", func() {
+		Expect(validate(
+			phase("Initializing", true, false),
+			phase("Processing", false, false),
+			phase("Finished", false, true),
+		)).NotTo(HaveOccurred())
+	})
+	It("accepts a graph in which every phase is reachable", func() {
+		Expect(validate(
+			phase("Initializing", true, false, "Processing"),
+			phase("Processing", false, false, "Finished", "Failed"),
+			phase("Failed", false, false),
+			phase("Finished", false, true),
+		)).NotTo(HaveOccurred())
+	})
+	It("requires exactly one initial phase", func() {
+		Expect(validate(
+			phase("Initializing", true, false),
+			phase("Processing", true, false),
+		)).To(MatchError(ContainSubstring("exactly one phase must be initial")))
+		Expect(validate(
+			phase("Processing", false, false),
+		)).To(MatchError(ContainSubstring("exactly one phase must be initial")))
+	})
+	It("rejects transitions to unknown phases", func() {
+		Expect(validate(
+			phase("Initializing", true, false, "Processing"),
+		)).To(MatchError("phase Initializing declares transition to unknown phase Processing"))
+	})
+	It("rejects transitions from final phases", func() {
+		Expect(validate(
+			phase("Initializing", true, false, "Finished"),
+			phase("Finished", false, true, "Initializing"),
+		)).To(MatchError("final phase Finished cannot declare transitions"))
+	})
+	It("rejects unreachable phases", func() {
+		Expect(validate(
+			phase("Initializing", true, false, "Finished"),
+			phase("Processing", false, false, "Finished"),
+			phase("Finished", false, true),
+		)).To(MatchError("phase Processing is unreachable from initial phase Initializing"))
+	})
+	Context("timeouts", func() {
+		timeout := func(phase Phase, onTimeout string) Phase {
+			phase.Timeout = ptypes.DurationProto(time.Minute)
+			phase.OnTimeout = onTimeout
+			return phase
+		}
+		It("treats onTimeout as a transition when checking reachability", func() {
+			Expect(validate(
+				timeout(phase("Initializing", true, false, "Processing"), "Failed"),
+				phase("Processing", false, false, "Finished"),
+				phase("Failed", false, true),
+				phase("Finished", false, true),
+			)).NotTo(HaveOccurred())
+		})
+		It("requires onTimeout to be a known phase", func() {
+			Expect(validate(
+				timeout(phase("Initializing", true, false), "Failed"),
+			)).To(MatchError("phase Initializing declares onTimeout to unknown phase Failed"))
+			Expect(validate(
+				timeout(phase("Initializing", true, false), ""),
+			)).To(MatchError("phase Initializing declares a timeout without onTimeout"))
+		})
+		It("rejects timeouts on final phases", func() {
+			Expect(validate(
+				phase("Initializing", true, false),
+				timeout(phase("Finished", false, true), "Initializing"),
+			)).To(MatchError("final phase Finished cannot declare a timeout"))
+		})
+	})
+
+	Context("error policy", func() {
+		It("treats onError as a transition when checking reachability", func() {
+			initializing := phase("Initializing", true, false, "Finished")
+			initializing.MaxRetries = 3
+			initializing.OnError = "Failed"
+			Expect(validate(
+				initializing,
+				phase("Failed", false, true),
+				phase("Finished", false, true),
+			)).NotTo(HaveOccurred())
+		})
+		It("requires onError when maxRetries is set", func() {
+			initializing := phase("Initializing", true, false)
+			initializing.MaxRetries = 3
+			Expect(validate(initializing)).To(MatchError("phase Initializing declares maxRetries without onError"))
+		})
+		It("validates the backoff", func() {
+			initializing := phase("Initializing", true, false)
+			initializing.Backoff = &v1.Backoff{
+				InitialInterval: ptypes.DurationProto(time.Minute),
+				MaxInterval:     ptypes.DurationProto(time.Second),
+			}
+			Expect(validate(initializing)).To(MatchError("phase Initializing backoff maxInterval must not be less than initialInterval"))
+		})
+	})
+	Context("input config", func() {
+		withInputConfig := func(cfg map[string]*v1.InputConfig) Phase {
+			initializing := phase("Initializing", true, false)
+			initializing.Inputs = []Parameter{Deployments}
+			initializing.InputConfig = cfg
+			return initializing
+		}
+		It("accepts selector templates for phase inputs", func() {
+			Expect(validate(withInputConfig(map[string]*v1.InputConfig{
+				"deployments": {LabelSelector: "app={{ .Name }}"},
+			}))).NotTo(HaveOccurred())
+		})
+		It("rejects configuration of unknown inputs", func() {
+			Expect(validate(withInputConfig(map[string]*v1.InputConfig{
+				"services": {LabelSelector: "app={{ .Name }}"},
+			}))).To(MatchError("phase Initializing configures unknown input services"))
+		})
+		It("validates input scopes", func() {
+			for _, scope := range []string{"crNamespace", "controlPlane", "all", "namespace:mesh"} {
+				Expect(validate(withInputConfig(map[string]*v1.InputConfig{
+					"deployments": {Scope: scope},
+				}))).NotTo(HaveOccurred())
+			}
+			Expect(validate(withInputConfig(map[string]*v1.InputConfig{
+				"deployments": {Scope: "namespace:"},
+			}))).To(MatchError(ContainSubstring(`phase Initializing input deployments has invalid scope "namespace:"`)))
+		})
+		It("accepts field selectors on indexed fields", func() {
+			Expect(validate(withInputConfig(map[string]*v1.InputConfig{
+				"deployments": {FieldSelector: "metadata.name={{ .Spec.Target }}"},
+			}))).NotTo(HaveOccurred())
+		})
+		It("rejects field selectors which the cache cannot serve", func() {
+			Expect(validate(withInputConfig(map[string]*v1.InputConfig{
+				"deployments": {FieldSelector: "status.phase=Running"},
+			}))).To(MatchError(ContainSubstring(`phase Initializing input deployments: unsupported field selector "status.phase=Running"`)))
+		})
+		It("rejects owner field selectors outside of the watched namespace", func() {
+			Expect(validate(withInputConfig(map[string]*v1.InputConfig{
+				"deployments": {FieldSelector: "metadata.ownerReferences.uid={{ .UID }}", Scope: "crNamespace"},
+			}))).NotTo(HaveOccurred())
+			Expect(validate(withInputConfig(map[string]*v1.InputConfig{
+				"deployments": {FieldSelector: "metadata.ownerReferences.uid={{ .UID }}", Scope: "all"},
+			}))).To(MatchError("phase Initializing input deployments cannot select by metadata.ownerReferences.uid outside of the watched namespace"))
+		})
+		It("rejects invalid selector templates", func() {
+			Expect(validate(withInputConfig(map[string]*v1.InputConfig{
+				"deployments": {LabelSelector: "app={{ .Name }"},
+			}))).To(MatchError(ContainSubstring("phase Initializing input deployments: parsing label selector")))
+		})
+	})
+	It("rejects configuration of unknown outputs", func() {
+		initializing := phase("Initializing", true, false)
+		initializing.Outputs = []Parameter{Deployments}
+		initializing.OutputConfig = map[string]*v1.OutputConfig{"services": {Prune: true}}
+		Expect(validate(initializing)).To(MatchError("phase Initializing configures unknown output services"))
+	})
+	Context("onSpecChange", func() {
+		phases := []Phase{
+			phase("Initializing", true, false, "Finished"),
+			phase("Finished", false, true),
+		}
+		specChange := func(policy string) *ProjectData {
+			d := &ProjectData{Phases: phases}
+			d.OnSpecChange = policy
+			return d
+		}
+		It("ignores spec changes by default", func() {
+			Expect(specChange("").SpecChangePhase()).To(BeNil())
+			Expect(specChange("ignore").SpecChangePhase()).To(BeNil())
+		})
+		It("resolves the phase to move to", func() {
+			Expect(specChange("restart").SpecChangePhase().Name).To(Equal("Initializing"))
+			Expect(specChange("phase:Finished").SpecChangePhase().Name).To(Equal("Finished"))
+		})
+		It("rejects invalid policies", func() {
+			Expect(specChange("phase:Processing").Validate()).To(MatchError("onSpecChange references unknown phase Processing"))
+			Expect(specChange("reset").Validate()).To(MatchError(ContainSubstring(`invalid onSpecChange policy "reset"`)))
+		})
+	})
+})
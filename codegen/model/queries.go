@@ -2,7 +2,8 @@ package model
 
 import v1 "github.com/solo-io/autopilot/api/v1"
 
-// Default queries are built-in to the system and will be generated for any user metrics client
+// Default queries are built-in to the system and will be generated for the metrics client
+// of operators using the Istio or Custom mesh providers
 var DefaultQueries = []v1.MetricsQuery{
 	{
 		Name: "istio-success-rate",
@@ -97,3 +98,68 @@ var DefaultQueries = []v1.MetricsQuery{
 		},
 	},
 }
+
+// Linkerd queries are built-in to the system and will be generated for the metrics client
+// of operators using the Linkerd mesh provider.
+// Latencies are measured in milliseconds
+var LinkerdQueries = []v1.MetricsQuery{
+	{
+		Name: "linkerd-success-rate",
+		QueryTemplate: `sum(
+		rate(
+			response_total{
+				namespace="{{ .Namespace }}",
+				deployment=~"{{ .Name }}",
+				direction="inbound",
+				classification!="failure"
+			}[{{ .Interval }}]
+		)
+	)
+	/
+	sum(
+		rate(
+			response_total{
+				namespace="{{ .Namespace }}",
+				deployment=~"{{ .Name }}",
+				direction="inbound"
+			}[{{ .Interval }}]
+		)
+	)
+	* 100`,
+		Parameters: []string{
+			"Namespace",
+			"Name",
+			"Interval",
+		},
+	},
+	{
+		Name: "linkerd-request-duration",
+		QueryTemplate: `histogram_quantile(
+		0.99,
+		sum(
+			rate(
+				response_latency_ms_bucket{
+					namespace="{{ .Namespace }}",
+					deployment=~"{{ .Name }}",
+					direction="inbound"
+				}[{{ .Interval }}]
+			)
+		) by (le)
+	)`,
+		Parameters: []string{
+			"Namespace",
+			"Name",
+			"Interval",
+		},
+	},
+}
+
+// the built-in queries generated for the metrics client of the given mesh provider
+func ProviderQueries(provider v1.MeshProvider) []v1.MetricsQuery {
+	switch provider {
+	case v1.MeshProvider_Linkerd:
+		return LinkerdQueries
+	default:
+		return DefaultQueries
+	}
+}
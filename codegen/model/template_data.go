@@ -3,6 +3,7 @@ package model
 import (
 	"fmt"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/gobuffalo/packr"
@@ -12,6 +13,8 @@ import (
 	"github.com/pkg/errors"
 	v1 "github.com/solo-io/autopilot/api/v1"
 	"github.com/solo-io/autopilot/codegen/util"
+	"github.com/solo-io/autopilot/pkg/config"
+	"github.com/solo-io/autopilot/pkg/defaults"
 )
 
 // ProjectData is used for rendering templates and generating files
@@ -27,6 +30,9 @@ type ProjectData struct {
 	// internal implementation of phases
 	Phases []Phase `json:"phases"`
 
+	// internal implementation of input watches
+	InputWatches []InputWatch `json:"inputWatches"`
+
 	ProjectPackage string // e.g. "github.com/solo-io/autopilot/examples/promoter"
 
 	Group   string // e.g. "mesh.demos.io"
@@ -39,12 +45,16 @@ type ProjectData struct {
 	FinalizerRelativePath  string // e.g. "pkg/finalizer"
 	ParametersRelativePath string // e.g. "pkg/parameters"
 	MetricsRelativePath    string // e.g. "pkg/metrics"
+	WebhooksRelativePath   string // e.g. "pkg/webhooks"
+	MappersRelativePath    string // e.g. "pkg/mappers"
 
 	TypesImportPath      string // e.g. "github.com/yourorg/yourproject/pkg/apis/canaries/v1"
 	SchedulerImportPath  string // e.g. "github.com/yourorg/yourproject/pkg/scheduler"
 	FinalizerImportPath  string // e.g. "github.com/yourorg/yourproject/pkg/finalizer"
 	ParametersImportPath string // e.g. "github.com/yourorg/yourproject/pkg/parameters"
 	MetricsImportPath    string // e.g. "github.com/yourorg/yourproject/pkg/metrics"
+	WebhooksImportPath   string // e.g. "github.com/yourorg/yourproject/pkg/webhooks"
+	MappersImportPath    string // e.g. "github.com/yourorg/yourproject/pkg/mappers"
 
 	KindLowerCamel  string // e.g. "YourKind"
 	KindLower       string // e.g. "yourresource"
@@ -54,11 +64,15 @@ type ProjectData struct {
 func NewTemplateData(project v1.AutopilotProject, operator v1.AutopilotOperator, templates packr.Box) (*ProjectData, error) {
 	projectGoPkg := util.GetGoPkg()
 
-	for _, q := range DefaultQueries {
+	for _, q := range ProviderQueries(operator.MeshProvider) {
 		q := q // Go!!
 		project.Queries = append(project.Queries, &q)
 	}
 
+	if project.PhaseHistoryLimit == 0 {
+		project.PhaseHistoryLimit = uint32(defaults.PhaseHistoryLimit)
+	}
+
 	apiVersionParts := strings.Split(project.ApiVersion, "/")
 
 	if len(apiVersionParts) != 2 {
@@ -85,6 +99,8 @@ func NewTemplateData(project v1.AutopilotProject, operator v1.AutopilotOperator,
 		FinalizerImportPath:  filepath.Join(projectGoPkg, FinalizerRelativePath),
 		ParametersImportPath: filepath.Join(projectGoPkg, ParametersRelativePath),
 		MetricsImportPath:    filepath.Join(projectGoPkg, MetricsRelativePath),
+		WebhooksImportPath:   filepath.Join(projectGoPkg, WebhooksRelativePath),
+		MappersImportPath:    filepath.Join(projectGoPkg, MappersRelativePath),
 		KindLowerCamel:       strcase.ToLowerCamel(project.Kind),
 		KindLower:            strings.ToLower(project.Kind),
 		KindLowerPlural:      pluralize.NewClient().Plural(strings.ToLower(project.Kind)),
@@ -108,12 +124,33 @@ func NewTemplateData(project v1.AutopilotProject, operator v1.AutopilotOperator,
 		})
 	}
 
+	for _, watch := range project.InputWatches {
+		params, err := paramsFromNames([]string{watch.Input})
+		if err != nil {
+			return nil, errors.Wrapf(err, "input watch %v", watch.Input)
+		}
+		data.InputWatches = append(data.InputWatches, InputWatch{
+			InputWatch: *watch,
+			Param:      params[0],
+			Project:    data,
+		})
+	}
+
 	return data, nil
 }
 
 var invalidMetricsOutputParamErr = fmt.Errorf("metrics is not a valid output parameter")
 
 func (d *ProjectData) Validate() error {
+	if err := validatePhaseGraph(d.Phases); err != nil {
+		return err
+	}
+	if _, err := d.specChangePhase(); err != nil {
+		return err
+	}
+	if err := validateInputWatches(d); err != nil {
+		return err
+	}
 	for _, phase := range d.Phases {
 		for _, out := range phase.Outputs {
 			if out.Equals(Metrics) {
@@ -124,6 +161,49 @@ func (d *ProjectData) Validate() error {
 	return nil
 }
 
+// the initial phase of the top-level CRD
+func (d *ProjectData) InitialPhase() Phase {
+	for _, phase := range d.Phases {
+		if phase.Initial {
+			return phase
+		}
+	}
+	return Phase{}
+}
+
+const (
+	OnSpecChangeIgnore      = "ignore"
+	OnSpecChangeRestart     = "restart"
+	onSpecChangePhasePrefix = "phase:"
+)
+
+// the phase to which the top-level CRD is moved when its spec changes,
+// nil if spec changes are ignored
+func (d *ProjectData) SpecChangePhase() *Phase {
+	phase, _ := d.specChangePhase()
+	return phase
+}
+
+func (d *ProjectData) specChangePhase() (*Phase, error) {
+	switch policy := d.OnSpecChange; {
+	case policy == "" || policy == OnSpecChangeIgnore:
+		return nil, nil
+	case policy == OnSpecChangeRestart:
+		initial := d.InitialPhase()
+		return &initial, nil
+	case strings.HasPrefix(policy, onSpecChangePhasePrefix):
+		name := strings.TrimPrefix(policy, onSpecChangePhasePrefix)
+		for _, phase := range d.Phases {
+			if phase.Name == name {
+				return &phase, nil
+			}
+		}
+		return nil, errors.Errorf("onSpecChange references unknown phase %v", name)
+	default:
+		return nil, errors.Errorf("invalid onSpecChange policy %q: must be one of %v, %v, or %v<Name>", policy, OnSpecChangeIgnore, OnSpecChangeRestart, onSpecChangePhasePrefix)
+	}
+}
+
 func (d *ProjectData) NeedsMetrics() bool {
 	for _, phase := range d.Phases {
 		for _, in := range phase.Inputs {
@@ -135,6 +215,118 @@ func (d *ProjectData) NeedsMetrics() bool {
 	return false
 }
 
+// the input watches which require a user-supplied mapper
+func (d *ProjectData) MapperWatches() []InputWatch {
+	var watches []InputWatch
+	for _, watch := range d.InputWatches {
+		if watch.ByMapper() {
+			watches = append(watches, watch)
+		}
+	}
+	return watches
+}
+
+func (d *ProjectData) NeedsMappers() bool {
+	return len(d.MapperWatches()) > 0
+}
+
+func (d *ProjectData) isOutput(param Parameter) bool {
+	for _, out := range d.UniqueOutputs() {
+		if out.Equals(param) {
+			return true
+		}
+	}
+	return false
+}
+
+// the namespace in which the Operator lists Services to discover Prometheus.
+// returns false if the Operator does not discover Prometheus
+func (d *ProjectData) MetricsDiscoveryNamespace() (string, bool) {
+	discovery := d.MetricsServer.GetDiscovery()
+	if !d.NeedsMetrics() || discovery == nil {
+		return "", false
+	}
+	if ns := discovery.GetNamespace(); ns != "" {
+		return ns, true
+	}
+	return config.ControlPlaneNamespace(&d.AutopilotOperator), true
+}
+
+// the namespaces other than the watched namespace in which inputs are listed, in order.
+// includes the namespace in which Prometheus is discovered, which requires the same read access
+func (d *ProjectData) ExternalInputNamespaces() []string {
+	var namespaces []string
+	seen := map[string]bool{}
+	if ns, ok := d.MetricsDiscoveryNamespace(); ok {
+		seen[ns] = true
+		namespaces = append(namespaces, ns)
+	}
+	for _, phase := range d.Phases {
+		for _, param := range phase.Inputs {
+			ns, external := phase.ExternalInputNamespace(param)
+			if !external || ns == "" || seen[ns] {
+				continue
+			}
+			seen[ns] = true
+			namespaces = append(namespaces, ns)
+		}
+	}
+	sort.Strings(namespaces)
+	return namespaces
+}
+
+// true if any input is listed outside of the namespace watched by the operator
+func (d *ProjectData) HasExternalInputs() bool {
+	for _, phase := range d.Phases {
+		for _, param := range phase.Inputs {
+			if phase.IsExternalInput(param) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// true if any input is listed in all namespaces
+func (d *ProjectData) HasClusterWideInputs() bool {
+	for _, phase := range d.Phases {
+		for _, param := range phase.Inputs {
+			if ns, external := phase.ExternalInputNamespace(param); external && ns == "" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (d *ProjectData) NeedsValidatingWebhook() bool {
+	return d.Webhooks.GetValidating()
+}
+
+func (d *ProjectData) NeedsMutatingWebhook() bool {
+	return d.Webhooks.GetMutating()
+}
+
+func (d *ProjectData) NeedsWebhooks() bool {
+	return d.NeedsValidatingWebhook() || d.NeedsMutatingWebhook()
+}
+
+// the path on which the validating webhook for the top-level CRD is served
+// e.g. "/validate-examples-io-v1-canary"
+func (d *ProjectData) ValidatingWebhookPath() string {
+	return "/validate-" + d.webhookPathSuffix()
+}
+
+// the path on which the mutating webhook for the top-level CRD is served
+// e.g. "/mutate-examples-io-v1-canary"
+func (d *ProjectData) MutatingWebhookPath() string {
+	return "/mutate-" + d.webhookPathSuffix()
+}
+
+func (d *ProjectData) webhookPathSuffix() string {
+	return strings.Replace(d.Group, ".", "-", -1) + "-" + d.Version + "-" + d.KindLower
+}
+
 // operator-local prometheus is currently disabled.
 // use prometheus.istio-system instead.
 func (d *ProjectData) NeedsPrometheus() bool {
@@ -159,6 +351,28 @@ func (d *ProjectData) UniqueOutputs() []Parameter {
 	return unique
 }
 
+// the unique inputs of all phases, excluding metrics
+func (d *ProjectData) UniqueInputs() []Parameter {
+	var unique []Parameter
+	addParam := func(param Parameter) {
+		for _, p := range unique {
+			if p.Equals(param) {
+				return
+			}
+		}
+		unique = append(unique, param)
+	}
+	for _, phase := range d.Phases {
+		for _, in := range phase.Inputs {
+			if in.Equals(Metrics) {
+				continue
+			}
+			addParam(in)
+		}
+	}
+	return unique
+}
+
 func (d *ProjectData) UniqueParams() []Parameter {
 	var unique []Parameter
 	addParam := func(param Parameter) {
@@ -0,0 +1,46 @@
+package model_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/autopilot/api/v1"
+	. "github.com/solo-io/autopilot/codegen/model"
+)
+
+var _ = Describe("Prometheus discovery", func() {
+	var data *ProjectData
+	BeforeEach(func() {
+		data = &ProjectData{Phases: []Phase{{
+			Phase:  v1.Phase{Name: "Evaluating", Initial: true},
+			Inputs: []Parameter{Metrics, Deployments},
+		}}}
+		data.MetricsServer = &v1.MetricsServer{Discovery: &v1.PrometheusDiscovery{Labels: map[string]string{"app": "prometheus"}}}
+	})
+
+	It("discovers Prometheus in the control plane namespace by default", func() {
+		data.ControlPlaneNs = "mesh-system"
+		ns, ok := data.MetricsDiscoveryNamespace()
+		Expect(ok).To(BeTrue())
+		Expect(ns).To(Equal("mesh-system"))
+		Expect(data.ExternalInputNamespaces()).To(Equal([]string{"mesh-system"}))
+	})
+	It("discovers Prometheus in the configured namespace", func() {
+		data.MetricsServer.Discovery.Namespace = "monitoring"
+		data.Phases[0].Project = data
+		Expect(data.HasExternalInputs()).To(BeFalse())
+		data.Phases[0].InputConfig = map[string]*v1.InputConfig{"deployments": {Scope: "controlPlane"}}
+		Expect(data.HasExternalInputs()).To(BeTrue())
+		Expect(data.ExternalInputNamespaces()).To(Equal([]string{"istio-system", "monitoring"}))
+	})
+	It("does not discover Prometheus without metrics inputs or discovery", func() {
+		data.MetricsServer.Discovery = nil
+		_, ok := data.MetricsDiscoveryNamespace()
+		Expect(ok).To(BeFalse())
+
+		data.MetricsServer.Discovery = &v1.PrometheusDiscovery{}
+		data.Phases[0].Inputs = []Parameter{Deployments}
+		_, ok = data.MetricsDiscoveryNamespace()
+		Expect(ok).To(BeFalse())
+		Expect(data.ExternalInputNamespaces()).To(BeEmpty())
+	})
+})
@@ -27,6 +27,9 @@ func (d ProjectData) Funcs() template.FuncMap {
 		"worker_import_prefix": WorkerDirName,
 		"worker_package":       d.workerPackage,
 		"needs_metrics":        d.NeedsMetrics,
+		"needs_webhooks":       d.NeedsWebhooks,
+		"needs_mappers":        d.NeedsMappers,
+		"unique_inputs":        d.UniqueInputs,
 		"unique_outputs":       d.UniqueOutputs,
 		"unique_params":        d.UniqueParams,
 	}
@@ -34,7 +34,7 @@ func deployment(data *model.ProjectData, clusterScoped bool) *appsv1.Deployment
 		watchNamespaceEnv.Value = metav1.NamespaceAll // watch all namespaces
 	}
 
-	return &appsv1.Deployment{
+	deploy := &appsv1.Deployment{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: data.OperatorName,
 		},
@@ -93,4 +93,34 @@ func deployment(data *model.ProjectData, clusterScoped bool) *appsv1.Deployment
 			},
 		},
 	}
+
+	if data.NeedsWebhooks() {
+		addWebhookCerts(data, &deploy.Spec.Template.Spec)
+	}
+
+	return deploy
+}
+
+// expose the webhook port and mount the cert-manager issued certificate
+func addWebhookCerts(data *model.ProjectData, podSpec *v1.PodSpec) {
+	volumeName := webhookCertSecretName(data)
+	container := &podSpec.Containers[0]
+	container.Ports = append(container.Ports, v1.ContainerPort{
+		Name:          "webhook",
+		ContainerPort: webhookPort(data),
+		Protocol:      v1.ProtocolTCP,
+	})
+	container.VolumeMounts = append(container.VolumeMounts, v1.VolumeMount{
+		Name:      volumeName,
+		ReadOnly:  true,
+		MountPath: webhookCertDir(data),
+	})
+	podSpec.Volumes = append(podSpec.Volumes, v1.Volume{
+		Name: volumeName,
+		VolumeSource: v1.VolumeSource{
+			Secret: &v1.SecretVolumeSource{
+				SecretName: webhookCertSecretName(data),
+			},
+		},
+	})
 }
@@ -22,7 +22,7 @@ func role(data *model.ProjectData) *v1.Role {
 			APIVersion: v1.SchemeGroupVersion.String(),
 			Kind:       "Role",
 		},
-		Rules: rules(data),
+		Rules: rules(data, false),
 	}
 }
 
@@ -39,7 +39,7 @@ func clusterRole(data *model.ProjectData) *v1.ClusterRole {
 			APIVersion: v1.SchemeGroupVersion.String(),
 			Kind:       "ClusterRole",
 		},
-		Rules: rules(data),
+		Rules: rules(data, true),
 	}
 }
 
@@ -61,11 +61,15 @@ func (p permission) verbs() []string {
 	return verbs
 }
 
-func rules(data *model.ProjectData) []v1.PolicyRule {
-	type paramPermission struct {
-		model.Parameter
-		permission
-	}
+type paramPermission struct {
+	model.Parameter
+	permission
+}
+
+// the rules required by the operator.
+// inputs scoped to other namespaces are only included if includeExternalInputs is true,
+// otherwise they are granted by the input roles
+func rules(data *model.ProjectData, includeExternalInputs bool) []v1.PolicyRule {
 	requiredPermissions := make(map[string]paramPermission)
 
 	setRead := func(param model.Parameter) {
@@ -86,6 +90,9 @@ func rules(data *model.ProjectData) []v1.PolicyRule {
 			if param.Equals(model.Metrics) {
 				continue
 			}
+			if _, external := phase.ExternalInputNamespace(param); external && !includeExternalInputs {
+				continue
+			}
 			setRead(param)
 		}
 		for _, param := range phase.Outputs {
@@ -101,6 +108,32 @@ func rules(data *model.ProjectData) []v1.PolicyRule {
 	setWrite(model.ConfigMaps)
 	setWrite(model.Events)
 
+	// required to discover Prometheus.
+	// namespace-scoped operators are granted this by the input role of the discovery namespace
+	if _, ok := data.MetricsDiscoveryNamespace(); ok && includeExternalInputs {
+		setRead(model.Services)
+	}
+
+	rules := policyRules(requiredPermissions)
+
+	rules = append(rules, v1.PolicyRule{
+		Verbs:     []string{"get", "list", "watch"},
+		APIGroups: []string{data.Group},
+		Resources: []string{
+			data.KindLowerPlural,
+		},
+	}, v1.PolicyRule{
+		Verbs:     []string{"update"},
+		APIGroups: []string{data.Group},
+		Resources: []string{
+			data.KindLowerPlural + "/status",
+		},
+	})
+
+	return rules
+}
+
+func policyRules(requiredPermissions map[string]paramPermission) []v1.PolicyRule {
 	var rules []v1.PolicyRule
 	for _, param := range requiredPermissions {
 		verbs := param.verbs()
@@ -124,19 +157,66 @@ func rules(data *model.ProjectData) []v1.PolicyRule {
 		return rules[i].Verbs[0] < rules[i].Verbs[0]
 	})
 
-	rules = append(rules, v1.PolicyRule{
-		Verbs:     []string{"get", "list", "watch"},
-		APIGroups: []string{data.Group},
-		Resources: []string{
-			data.KindLowerPlural,
+	return rules
+}
+
+// the name of the Roles and ClusterRole granting access to inputs scoped to other namespaces
+func inputRoleName(data *model.ProjectData) string {
+	return data.OperatorName + "-inputs"
+}
+
+// the read rules for the inputs listed in the given namespace,
+// and for the Services listed to discover Prometheus if it is discovered there.
+// an empty namespace selects the inputs listed in all namespaces
+func inputRules(data *model.ProjectData, namespace string) []v1.PolicyRule {
+	requiredPermissions := make(map[string]paramPermission)
+	if ns, ok := data.MetricsDiscoveryNamespace(); ok && ns == namespace {
+		requiredPermissions[model.Services.String()] = paramPermission{
+			Parameter:  model.Services,
+			permission: permission{read: true},
+		}
+	}
+	for _, phase := range data.Phases {
+		for _, param := range phase.Inputs {
+			if ns, external := phase.ExternalInputNamespace(param); external && ns == namespace {
+				requiredPermissions[param.String()] = paramPermission{
+					Parameter:  param,
+					permission: permission{read: true},
+				}
+			}
+		}
+	}
+	return policyRules(requiredPermissions)
+}
+
+// InputRole grants a namespace-scoped operator read access to the inputs listed in another namespace,
+// and to the Services listed there to discover Prometheus
+func InputRole(namespace string) func(data *model.ProjectData) runtime.Object {
+	return func(data *model.ProjectData) runtime.Object {
+		return &v1.Role{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      inputRoleName(data),
+				Namespace: namespace,
+			},
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: v1.SchemeGroupVersion.String(),
+				Kind:       "Role",
+			},
+			Rules: inputRules(data, namespace),
+		}
+	}
+}
+
+// InputClusterRole grants a namespace-scoped operator read access to the inputs listed in all namespaces
+func InputClusterRole(data *model.ProjectData) runtime.Object {
+	return &v1.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: inputRoleName(data),
 		},
-	}, v1.PolicyRule{
-		Verbs:     []string{"update"},
-		APIGroups: []string{data.Group},
-		Resources: []string{
-			data.KindLowerPlural + "/status",
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       "ClusterRole",
 		},
-	})
-
-	return rules
+		Rules: inputRules(data, metav1.NamespaceAll),
+	}
 }
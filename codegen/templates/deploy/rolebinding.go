@@ -57,3 +57,50 @@ func clusterRoleBinding(data *model.ProjectData) *v1.ClusterRoleBinding {
 		},
 	}
 }
+
+func InputRoleBinding(namespace string) func(data *model.ProjectData) runtime.Object {
+	return func(data *model.ProjectData) runtime.Object {
+		return &v1.RoleBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      inputRoleName(data),
+				Namespace: namespace,
+			},
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: v1.SchemeGroupVersion.String(),
+				Kind:       "RoleBinding",
+			},
+			Subjects: []v1.Subject{{
+				Kind:      "ServiceAccount",
+				Name:      data.OperatorName,
+				Namespace: "REPLACE_NAMESPACE",
+			}},
+			RoleRef: v1.RoleRef{
+				APIGroup: "rbac.authorization.k8s.io",
+				Kind:     "Role",
+				Name:     inputRoleName(data),
+			},
+		}
+	}
+}
+
+func InputClusterRoleBinding(data *model.ProjectData) runtime.Object {
+	return &v1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: inputRoleName(data),
+		},
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       "ClusterRoleBinding",
+		},
+		Subjects: []v1.Subject{{
+			Kind:      "ServiceAccount",
+			Name:      data.OperatorName,
+			Namespace: "REPLACE_NAMESPACE",
+		}},
+		RoleRef: v1.RoleRef{
+			APIGroup: "rbac.authorization.k8s.io",
+			Kind:     "ClusterRole",
+			Name:     inputRoleName(data),
+		},
+	}
+}
@@ -0,0 +1,190 @@
+package deploy
+
+import (
+	"github.com/solo-io/autopilot/codegen/model"
+	"github.com/solo-io/autopilot/pkg/defaults"
+	admissionregistrationv1beta1 "k8s.io/api/admissionregistration/v1beta1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// certificates for the webhook server are provisioned by cert-manager
+// https://cert-manager.io
+const certManagerApiVersion = "cert-manager.io/v1alpha2"
+
+func webhookServiceName(data *model.ProjectData) string {
+	return data.OperatorName + "-webhook"
+}
+
+func webhookCertSecretName(data *model.ProjectData) string {
+	return data.OperatorName + "-webhook-cert"
+}
+
+func webhookIssuerName(data *model.ProjectData) string {
+	return data.OperatorName + "-selfsigned"
+}
+
+func webhookCertificateName(data *model.ProjectData) string {
+	return data.OperatorName + "-webhook"
+}
+
+func webhookPort(data *model.ProjectData) int32 {
+	if data.WebhookPort == 0 {
+		return int32(defaults.WebhookPort)
+	}
+	return int32(data.WebhookPort)
+}
+
+func webhookCertDir(data *model.ProjectData) string {
+	if data.WebhookCertDir == "" {
+		return defaults.WebhookCertDir
+	}
+	return data.WebhookCertDir
+}
+
+func WebhookService(data *model.ProjectData) runtime.Object {
+	return webhookService(data)
+}
+
+func webhookService(data *model.ProjectData) *v1.Service {
+	return &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: webhookServiceName(data),
+		},
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       "Service",
+		},
+		Spec: v1.ServiceSpec{
+			Selector: map[string]string{"name": data.OperatorName},
+			Ports: []v1.ServicePort{{
+				Name:       "webhook",
+				Port:       443,
+				TargetPort: intstr.FromInt(int(webhookPort(data))),
+			}},
+		},
+	}
+}
+
+func WebhookIssuer(data *model.ProjectData) runtime.Object {
+	return webhookIssuer(data)
+}
+
+// a self-signed cert-manager Issuer for the webhook certificate
+func webhookIssuer(data *model.ProjectData) *unstructured.Unstructured {
+	issuer := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{
+			"selfSigned": map[string]interface{}{},
+		},
+	}}
+	issuer.SetAPIVersion(certManagerApiVersion)
+	issuer.SetKind("Issuer")
+	issuer.SetName(webhookIssuerName(data))
+	return issuer
+}
+
+func WebhookCertificate(data *model.ProjectData) runtime.Object {
+	return webhookCertificate(data)
+}
+
+// the cert-manager Certificate whose secret is mounted to the operator to serve webhooks
+func webhookCertificate(data *model.ProjectData) *unstructured.Unstructured {
+	svc := webhookServiceName(data)
+	cert := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{
+			"secretName": webhookCertSecretName(data),
+			"dnsNames": []interface{}{
+				svc + ".REPLACE_NAMESPACE.svc",
+				svc + ".REPLACE_NAMESPACE.svc.cluster.local",
+			},
+			"issuerRef": map[string]interface{}{
+				"kind": "Issuer",
+				"name": webhookIssuerName(data),
+			},
+		},
+	}}
+	cert.SetAPIVersion(certManagerApiVersion)
+	cert.SetKind("Certificate")
+	cert.SetName(webhookCertificateName(data))
+	return cert
+}
+
+func ValidatingWebhookConfiguration(data *model.ProjectData) runtime.Object {
+	return validatingWebhookConfiguration(data)
+}
+
+func validatingWebhookConfiguration(data *model.ProjectData) *admissionregistrationv1beta1.ValidatingWebhookConfiguration {
+	return &admissionregistrationv1beta1.ValidatingWebhookConfiguration{
+		ObjectMeta: webhookConfigurationMeta(data),
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: admissionregistrationv1beta1.SchemeGroupVersion.String(),
+			Kind:       "ValidatingWebhookConfiguration",
+		},
+		Webhooks: []admissionregistrationv1beta1.Webhook{
+			webhook(data, "v", data.ValidatingWebhookPath(),
+				admissionregistrationv1beta1.Create,
+				admissionregistrationv1beta1.Update,
+				admissionregistrationv1beta1.Delete,
+			),
+		},
+	}
+}
+
+func MutatingWebhookConfiguration(data *model.ProjectData) runtime.Object {
+	return mutatingWebhookConfiguration(data)
+}
+
+func mutatingWebhookConfiguration(data *model.ProjectData) *admissionregistrationv1beta1.MutatingWebhookConfiguration {
+	return &admissionregistrationv1beta1.MutatingWebhookConfiguration{
+		ObjectMeta: webhookConfigurationMeta(data),
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: admissionregistrationv1beta1.SchemeGroupVersion.String(),
+			Kind:       "MutatingWebhookConfiguration",
+		},
+		Webhooks: []admissionregistrationv1beta1.Webhook{
+			webhook(data, "m", data.MutatingWebhookPath(),
+				admissionregistrationv1beta1.Create,
+				admissionregistrationv1beta1.Update,
+			),
+		},
+	}
+}
+
+// cert-manager's CA injector populates the caBundle from the webhook Certificate
+func webhookConfigurationMeta(data *model.ProjectData) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name: data.OperatorName,
+		Annotations: map[string]string{
+			"cert-manager.io/inject-ca-from": "REPLACE_NAMESPACE/" + webhookCertificateName(data),
+		},
+	}
+}
+
+func webhook(data *model.ProjectData, prefix, path string, operations ...admissionregistrationv1beta1.OperationType) admissionregistrationv1beta1.Webhook {
+	failurePolicy := admissionregistrationv1beta1.Fail
+	sideEffects := admissionregistrationv1beta1.SideEffectClassNone
+	return admissionregistrationv1beta1.Webhook{
+		// webhook names must be fully qualified
+		Name: prefix + data.KindLower + "." + data.Group,
+		ClientConfig: admissionregistrationv1beta1.WebhookClientConfig{
+			Service: &admissionregistrationv1beta1.ServiceReference{
+				Name:      webhookServiceName(data),
+				Namespace: "REPLACE_NAMESPACE",
+				Path:      &path,
+			},
+		},
+		Rules: []admissionregistrationv1beta1.RuleWithOperations{{
+			Operations: operations,
+			Rule: admissionregistrationv1beta1.Rule{
+				APIGroups:   []string{data.Group},
+				APIVersions: []string{data.Version},
+				Resources:   []string{data.KindLowerPlural},
+			},
+		}},
+		FailurePolicy: &failurePolicy,
+		SideEffects:   &sideEffects,
+	}
+}
@@ -31,6 +31,10 @@ var DefaultConfig = v1.AutopilotOperator{
 	WatchNamespace: os.Getenv(defaults.WatchNamespaceEnvVar),
 
 	LogLevel: &wrappers.UInt32Value{Value: 1},
+
+	WebhookPort: uint32(defaults.WebhookPort),
+
+	WebhookCertDir: defaults.WebhookCertDir,
 }
 
 // GetConfig attempts to read the autopilot-operator.yaml config file
@@ -50,6 +54,18 @@ func ConfigFromFile(file string) (*v1.AutopilotOperator, error) {
 	return &cfg, nil
 }
 
+// ControlPlaneNamespace returns the controlPlaneNs of the operator config,
+// defaulting to the namespace in which the mesh provider is installed by default
+func ControlPlaneNamespace(cfg *v1.AutopilotOperator) string {
+	if ns := cfg.GetControlPlaneNs(); ns != "" {
+		return ns
+	}
+	if cfg.GetMeshProvider() == v1.MeshProvider_Linkerd {
+		return defaults.LinkerdNamespace
+	}
+	return defaults.IstioNamespace
+}
+
 var ContextKey = &v1.AutopilotOperator{}
 
 func ConfigFromContext(ctx context.Context) *v1.AutopilotOperator {
@@ -13,6 +13,18 @@ var (
 
 	// Default installation namespace for Istio
 	IstioNamespace = "istio-system"
+
+	// Default installation namespace for Linkerd
+	LinkerdNamespace = "linkerd"
+
+	// Default port on which the operator serves admission webhooks
+	WebhookPort = 9443
+
+	// Default directory from which the webhook server loads its TLS certificate and key
+	WebhookCertDir = "/tmp/k8s-webhook-server/serving-certs"
+
+	// Default number of phase transitions recorded in the status of the top-level CRD
+	PhaseHistoryLimit = 10
 )
 
 const (
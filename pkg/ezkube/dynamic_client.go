@@ -2,6 +2,7 @@ package ezkube
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/solo-io/autopilot/pkg/utils"
 	"k8s.io/client-go/util/retry"
@@ -9,6 +10,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	controllerruntime "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 )
 
@@ -31,8 +33,15 @@ type ReconcileFunc func(old Object, new Object) (*Object, error)
 type Ensurer interface {
 	// optional reconcile funcs can be passed which determine how
 	// a child object should be reconciled with the existing object
-	// when it already exists in the cluster
-	Ensure(ctx context.Context, parent Object, child Object, reconcileFuncs ...ReconcileFunc) error
+	// when it already exists in the cluster.
+	// The update is skipped if every field set on the child already matches the existing object (see ObjectMatches).
+	// Returns true if the object was created or updated.
+	Ensure(ctx context.Context, parent Object, child Object, reconcileFuncs ...ReconcileFunc) (bool, error)
+
+	// EnsureApplied writes the child object with server-side apply rather than a full update.
+	// The fieldManager (e.g. the name of the Operator) takes ownership of the fields set on the child,
+	// leaving fields owned by other managers untouched. Conflicting fields are forcibly taken over.
+	EnsureApplied(ctx context.Context, fieldManager string, parent Object, child Object) error
 }
 
 // Client is an interface for interacting with the k8s rest api
@@ -107,10 +116,10 @@ func (c *simpleClient) Manager() manager.Manager {
 	return c.mgr
 }
 
-func (c *simpleClient) Ensure(ctx context.Context, parent Object, child Object, reconcileFuncs ...ReconcileFunc) error {
+func (c *simpleClient) Ensure(ctx context.Context, parent Object, child Object, reconcileFuncs ...ReconcileFunc) (bool, error) {
 	if parent != nil {
 		if err := controllerruntime.SetControllerReference(parent, child, c.mgr.GetScheme()); err != nil {
-			return err
+			return false, err
 		}
 	}
 
@@ -119,25 +128,41 @@ func (c *simpleClient) Ensure(ctx context.Context, parent Object, child Object,
 	for _, reconcile := range reconcileFuncs {
 		reconciledObj, err := reconcile(orig, child)
 		if err != nil {
-			return err
+			return false, err
 		}
 		if reconciledObj == nil {
-			return nil
+			return false, nil
 		}
 		child = *reconciledObj
 	}
 
-	if err := c.Get(ctx, orig); err != nil {
+	// read the existing object into an empty object of the same type,
+	// so no fields of the child are carried over
+	existing, err := c.newObject(child)
+	if err != nil {
+		return false, err
+	}
+	existing.SetNamespace(child.GetNamespace())
+	existing.SetName(child.GetName())
+
+	if err := c.Get(ctx, existing); err != nil {
 		if errors.IsNotFound(err) {
-			return c.Create(ctx, child)
+			return true, c.Create(ctx, child)
 		}
-		return err
+		return false, err
+	}
+
+	// skip the update if nothing would change
+	if matches, err := ObjectMatches(child, existing); err != nil {
+		return false, err
+	} else if matches {
+		return false, nil
 	}
 
-	child.SetResourceVersion(orig.GetResourceVersion())
+	child.SetResourceVersion(existing.GetResourceVersion())
 
 	// retry on resource version conflict
-	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+	return true, retry.RetryOnConflict(retry.DefaultBackoff, func() error {
 		err := c.Update(ctx, child)
 		if errors.IsConflict(err) {
 			utils.LoggerFromContext(ctx).Info("retrying on resource conflict")
@@ -149,6 +174,43 @@ func (c *simpleClient) Ensure(ctx context.Context, parent Object, child Object,
 	})
 }
 
+// newObject returns an empty object of the same type as obj
+func (c *simpleClient) newObject(obj Object) (Object, error) {
+	gvk, err := apiutil.GVKForObject(obj, c.mgr.GetScheme())
+	if err != nil {
+		return nil, err
+	}
+	newObj, err := c.mgr.GetScheme().New(gvk)
+	if err != nil {
+		return nil, err
+	}
+	typed, ok := newObj.(Object)
+	if !ok {
+		return nil, fmt.Errorf("%v is not an ezkube.Object", gvk)
+	}
+	return typed, nil
+}
+
+func (c *simpleClient) EnsureApplied(ctx context.Context, fieldManager string, parent Object, child Object) error {
+	if parent != nil {
+		if err := controllerruntime.SetControllerReference(parent, child, c.mgr.GetScheme()); err != nil {
+			return err
+		}
+	}
+
+	// apply patches are sent as the full object, which must include its apiVersion and kind
+	gvk, err := apiutil.GVKForObject(child, c.mgr.GetScheme())
+	if err != nil {
+		return err
+	}
+	child.GetObjectKind().SetGroupVersionKind(gvk)
+
+	// a resource version would be treated as a precondition on the apply
+	child.SetResourceVersion("")
+
+	return c.mgr.GetClient().Patch(ctx, child, client.Apply, client.FieldOwner(fieldManager), client.ForceOwnership)
+}
+
 func (c *simpleClient) UpdateResourceVersion(ctx context.Context, obj Object) error {
 
 	clone := obj.DeepCopyObject().(Object)
@@ -51,8 +51,9 @@ var _ = Describe("SimpleClient", func() {
 		err := client.Create(context.TODO(), parent)
 		Expect(err).NotTo(HaveOccurred())
 
-		err = client.Ensure(context.TODO(), parent, child)
+		written, err := client.Ensure(context.TODO(), parent, child)
 		Expect(err).NotTo(HaveOccurred())
+		Expect(written).To(BeTrue())
 
 		actualParent := &v1.ConfigMap{
 			ObjectMeta: metav1.ObjectMeta{
@@ -75,6 +76,12 @@ var _ = Describe("SimpleClient", func() {
 			return err
 		}).Should(Not(HaveOccurred()))
 		Expect(actualChild.Data).To(Equal(child.Data))
+
+		// ensuring the unchanged child again is a no-op
+		Eventually(func() (bool, error) {
+			return client.Ensure(context.TODO(), parent, child.DeepCopy())
+		}).Should(BeFalse())
+
 		Expect(actualChild.OwnerReferences).To(HaveLen(1))
 		t := true
 		Expect(actualChild.OwnerReferences[0]).To(Equal(metav1.OwnerReference{
@@ -101,4 +108,48 @@ var _ = Describe("SimpleClient", func() {
 		Expect(errors.IsNotFound(err)).To(BeTrue())
 
 	})
+
+	It("applies resources without overwriting fields set by other managers", func() {
+		r := rand.String(4)
+		childName := "applied-" + r
+
+		ns := "default"
+		existing := &v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns,
+				Name:      childName,
+			},
+			Data: map[string]string{"other": "data"},
+		}
+
+		client := NewClient(mgr)
+		err := client.Create(context.TODO(), existing)
+		Expect(err).NotTo(HaveOccurred())
+
+		child := &v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns,
+				Name:      childName,
+			},
+			Data: map[string]string{"applied": "data"},
+		}
+		err = client.EnsureApplied(context.TODO(), "ezkube-test", nil, child)
+		Expect(err).NotTo(HaveOccurred())
+
+		actualChild := &v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns,
+				Name:      childName,
+			},
+		}
+		Eventually(func() map[string]string {
+			if err := client.Get(context.TODO(), actualChild); err != nil {
+				return nil
+			}
+			return actualChild.Data
+		}).Should(Equal(map[string]string{"other": "data", "applied": "data"}))
+
+		err = client.Delete(context.TODO(), actualChild)
+		Expect(err).NotTo(HaveOccurred())
+	})
 })
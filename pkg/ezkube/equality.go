@@ -0,0 +1,121 @@
+package ezkube
+
+import (
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// fields which are maintained by the server and never compared
+var ignoredFields = map[string]bool{
+	"status": true,
+}
+
+// metadata fields which are maintained by the server, and the finalizers, which are compared as a set
+var ignoredMetadataFields = map[string]bool{
+	"resourceVersion":   true,
+	"generation":        true,
+	"uid":               true,
+	"selfLink":          true,
+	"creationTimestamp": true,
+	"managedFields":     true,
+	"finalizers":        true,
+}
+
+// ObjectMatches returns true if every field set on the desired object is semantically equal
+// to the same field on the live object. Fields which are unset on the desired object,
+// such as those defaulted by the server or set by other controllers, are ignored, as is the status.
+// Lists are compared element-wise and must be of equal length, except for the finalizers.
+// Other controllers add their own labels, annotations and finalizers, so only the keys and finalizers
+// of the desired object are compared: removing one of them is not written until another field changes.
+func ObjectMatches(desired, live runtime.Object) (bool, error) {
+	desiredFields, err := runtime.DefaultUnstructuredConverter.ToUnstructured(desired)
+	if err != nil {
+		return false, err
+	}
+	liveFields, err := runtime.DefaultUnstructuredConverter.ToUnstructured(live)
+	if err != nil {
+		return false, err
+	}
+	for key, desiredVal := range desiredFields {
+		if ignoredFields[key] {
+			continue
+		}
+		liveVal := liveFields[key]
+		if key == "metadata" {
+			if !finalizersMatch(desiredVal, liveVal) {
+				return false, nil
+			}
+			desiredVal = withoutFields(desiredVal, ignoredMetadataFields)
+		}
+		if !valueMatches(desiredVal, liveVal) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// true if every finalizer of the desired object is present on the live object, in any order
+func finalizersMatch(desiredMeta, liveMeta interface{}) bool {
+	desiredFields, _ := desiredMeta.(map[string]interface{})
+	liveFields, _ := liveMeta.(map[string]interface{})
+	desiredFinalizers, _ := desiredFields["finalizers"].([]interface{})
+	liveFinalizers, _ := liveFields["finalizers"].([]interface{})
+	present := map[interface{}]bool{}
+	for _, finalizer := range liveFinalizers {
+		present[finalizer] = true
+	}
+	for _, finalizer := range desiredFinalizers {
+		if !present[finalizer] {
+			return false
+		}
+	}
+	return true
+}
+
+func withoutFields(val interface{}, ignored map[string]bool) interface{} {
+	fields, ok := val.(map[string]interface{})
+	if !ok {
+		return val
+	}
+	filtered := map[string]interface{}{}
+	for key, v := range fields {
+		if !ignored[key] {
+			filtered[key] = v
+		}
+	}
+	return filtered
+}
+
+func valueMatches(desired, live interface{}) bool {
+	switch desiredVal := desired.(type) {
+	case nil:
+		return true
+	case map[string]interface{}:
+		liveVal, ok := live.(map[string]interface{})
+		if !ok {
+			return len(desiredVal) == 0 && live == nil
+		}
+		for key, v := range desiredVal {
+			if !valueMatches(v, liveVal[key]) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		liveVal, ok := live.([]interface{})
+		if !ok {
+			return len(desiredVal) == 0 && live == nil
+		}
+		if len(desiredVal) != len(liveVal) {
+			return false
+		}
+		for i := range desiredVal {
+			if !valueMatches(desiredVal[i], liveVal[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return equality.Semantic.DeepEqual(desired, live)
+	}
+}
@@ -0,0 +1,79 @@
+package ezkube_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/solo-io/autopilot/pkg/ezkube"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+var _ = Describe("ObjectMatches", func() {
+	var (
+		desired *appsv1.Deployment
+		live    *appsv1.Deployment
+	)
+	BeforeEach(func() {
+		desired = &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "petstore",
+				Labels:    map[string]string{"app": "petstore"},
+			},
+			Spec: appsv1.DeploymentSpec{
+				Replicas: pointer.Int32Ptr(2),
+				Template: v1.PodTemplateSpec{
+					Spec: v1.PodSpec{
+						Containers: []v1.Container{{Name: "petstore", Image: "petstore:v1"}},
+					},
+				},
+			},
+		}
+
+		// the live object has fields set by the server and other controllers
+		live = desired.DeepCopy()
+		live.ResourceVersion = "12"
+		live.UID = "abc"
+		live.Spec.RevisionHistoryLimit = pointer.Int32Ptr(10)
+		live.Spec.Template.Spec.Containers[0].TerminationMessagePath = "/dev/termination-log"
+		live.Status.Replicas = 2
+	})
+	It("ignores fields which are not set on the desired object", func() {
+		Expect(ObjectMatches(desired, live)).To(BeTrue())
+	})
+	It("detects changed fields", func() {
+		desired.Spec.Replicas = pointer.Int32Ptr(0)
+		Expect(ObjectMatches(desired, live)).To(BeFalse())
+	})
+	It("detects changes to the length of lists", func() {
+		desired.Spec.Template.Spec.Containers = append(desired.Spec.Template.Spec.Containers, v1.Container{Name: "sidecar"})
+		Expect(ObjectMatches(desired, live)).To(BeFalse())
+	})
+	It("ignores labels, annotations and finalizers added by other controllers", func() {
+		live.Labels["pod-template-hash"] = "abc"
+		live.Annotations = map[string]string{"deployment.kubernetes.io/revision": "1"}
+		live.Finalizers = []string{"example.io/finalizer"}
+		Expect(ObjectMatches(desired, live)).To(BeTrue())
+	})
+	It("detects changed labels and annotations", func() {
+		desired.Labels["app"] = "petstore-v2"
+		Expect(ObjectMatches(desired, live)).To(BeFalse())
+
+		desired.Labels["app"] = "petstore"
+		desired.Annotations = map[string]string{"note": "added"}
+		Expect(ObjectMatches(desired, live)).To(BeFalse())
+	})
+	It("detects missing finalizers regardless of their order", func() {
+		desired.Finalizers = []string{"example.io/finalizer", "other.io/finalizer"}
+		Expect(ObjectMatches(desired, live)).To(BeFalse())
+		live.Finalizers = []string{"other.io/finalizer", "foregroundDeletion", "example.io/finalizer"}
+		Expect(ObjectMatches(desired, live)).To(BeTrue())
+	})
+	It("treats empty and unset metadata as equal", func() {
+		desired.Annotations = map[string]string{}
+		desired.Finalizers = []string{}
+		Expect(ObjectMatches(desired, live)).To(BeTrue())
+	})
+})
@@ -0,0 +1,502 @@
+// Package fake provides an in-memory implementation of ezkube.Client
+// for unit-testing workers without a Kubernetes cluster.
+package fake
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/solo-io/autopilot/pkg/ezkube"
+	"k8s.io/apimachinery/pkg/api/equality"
+	kubeerrs "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/testing"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// Client is an in-memory ezkube.Client backed by an object tracker.
+// It mimics the behavior of the API server which workers and the scheduler rely upon:
+//   - created objects are assigned a UID, creation timestamp, resource version and generation
+//   - updates with a stale resource version fail with a conflict
+//   - Update preserves the status of the object, UpdateStatus preserves everything else
+//   - the generation is incremented when an update changes anything other than metadata and status
+//   - deleting an object with finalizers sets its deletion timestamp instead
+//
+// EnsureApplied approximates server-side apply by merging the fields set on the child
+// into the existing object. Field ownership is not tracked.
+type Client struct {
+	scheme  *runtime.Scheme
+	codecs  serializer.CodecFactory
+	tracker testing.ObjectTracker
+
+	lock            sync.Mutex
+	resourceVersion int64
+}
+
+var _ ezkube.Client = &Client{}
+
+// NewClient creates a Client seeded with the given objects.
+// The scheme must contain the types of all objects read or written with the Client.
+func NewClient(scheme *runtime.Scheme, objs ...runtime.Object) (*Client, error) {
+	codecs := serializer.NewCodecFactory(scheme)
+	c := &Client{
+		scheme:  scheme,
+		codecs:  codecs,
+		tracker: testing.NewObjectTracker(scheme, codecs.UniversalDecoder()),
+	}
+	for _, obj := range objs {
+		if err := c.seed(obj); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+// NewClientFromYaml creates a Client seeded with the objects in the given YAML manifests.
+// Each manifest may contain multiple objects separated by `---`.
+func NewClientFromYaml(scheme *runtime.Scheme, manifests ...string) (*Client, error) {
+	c, err := NewClient(scheme)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.AddYaml(manifests...); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// AddYaml seeds the Client with the objects in the given YAML manifests
+func (c *Client) AddYaml(manifests ...string) error {
+	decoder := c.codecs.UniversalDeserializer()
+	for _, manifest := range manifests {
+		reader := utilyaml.NewYAMLReader(bufio.NewReader(strings.NewReader(manifest)))
+		for {
+			doc, err := reader.Read()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			if strings.TrimSpace(string(doc)) == "" {
+				continue
+			}
+			obj, _, err := decoder.Decode(doc, nil, nil)
+			if err != nil {
+				return err
+			}
+			if err := c.seed(obj); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (c *Client) seed(obj runtime.Object) error {
+	typed, ok := obj.(ezkube.Object)
+	if !ok {
+		return fmt.Errorf("cannot seed object of type %T", obj)
+	}
+	return c.Create(context.TODO(), typed.DeepCopyObject().(ezkube.Object))
+}
+
+// Manager returns nil, as the fake Client is not backed by a manager
+func (c *Client) Manager() manager.Manager {
+	return nil
+}
+
+func (c *Client) Get(ctx context.Context, obj ezkube.Object) error {
+	gvr, err := c.resourceFor(obj)
+	if err != nil {
+		return err
+	}
+	existing, err := c.tracker.Get(gvr, obj.GetNamespace(), obj.GetName())
+	if err != nil {
+		return err
+	}
+	return copyInto(existing, obj)
+}
+
+func (c *Client) List(ctx context.Context, obj ezkube.List, options ...client.ListOption) error {
+	gvk, err := apiutil.GVKForObject(obj, c.scheme)
+	if err != nil {
+		return err
+	}
+	if !strings.HasSuffix(gvk.Kind, "List") {
+		return fmt.Errorf("non-list type %T (kind %q) passed to List", obj, gvk)
+	}
+	gvk.Kind = strings.TrimSuffix(gvk.Kind, "List")
+	gvr, _ := apimeta.UnsafeGuessKindToResource(gvk)
+
+	opts := &client.ListOptions{}
+	opts.ApplyOptions(options)
+
+	list, err := c.tracker.List(gvr, gvk, opts.Namespace)
+	if err != nil {
+		return err
+	}
+	items, err := apimeta.ExtractList(list)
+	if err != nil {
+		return err
+	}
+	var matching []runtime.Object
+	for _, item := range items {
+		matches, err := listOptionsMatch(opts, item)
+		if err != nil {
+			return err
+		}
+		if matches {
+			matching = append(matching, item)
+		}
+	}
+	if err := apimeta.SetList(list, matching); err != nil {
+		return err
+	}
+	return copyInto(list, obj)
+}
+
+func (c *Client) Create(ctx context.Context, obj ezkube.Object) error {
+	gvr, err := c.resourceFor(obj)
+	if err != nil {
+		return err
+	}
+	if obj.GetName() == "" && obj.GetGenerateName() != "" {
+		obj.SetName(obj.GetGenerateName() + strings.ToLower(string(uuid.NewUUID())[:5]))
+	}
+	if obj.GetResourceVersion() != "" {
+		return kubeerrs.NewBadRequest("resourceVersion should not be set on objects to be created")
+	}
+	if obj.GetUID() == "" {
+		obj.SetUID(uuid.NewUUID())
+	}
+	if created := obj.GetCreationTimestamp(); created.IsZero() {
+		obj.SetCreationTimestamp(metav1.Now())
+	}
+	obj.SetGeneration(1)
+	obj.SetResourceVersion(c.nextResourceVersion())
+	return c.tracker.Create(gvr, obj, obj.GetNamespace())
+}
+
+// Update replaces the object, preserving its status
+func (c *Client) Update(ctx context.Context, obj ezkube.Object) error {
+	return c.update(obj, false)
+}
+
+// UpdateStatus replaces the status of the object, preserving everything else
+func (c *Client) UpdateStatus(ctx context.Context, obj ezkube.Object) error {
+	return c.update(obj, true)
+}
+
+func (c *Client) update(obj ezkube.Object, status bool) error {
+	gvr, err := c.resourceFor(obj)
+	if err != nil {
+		return err
+	}
+	existingObj, err := c.tracker.Get(gvr, obj.GetNamespace(), obj.GetName())
+	if err != nil {
+		return err
+	}
+	existing := existingObj.(ezkube.Object)
+	if obj.GetResourceVersion() != "" && obj.GetResourceVersion() != existing.GetResourceVersion() {
+		return kubeerrs.NewConflict(gvr.GroupResource(), obj.GetName(),
+			fmt.Errorf("the object has been modified; please apply your changes to the latest version and try again"))
+	}
+
+	existingFields, err := runtime.DefaultUnstructuredConverter.ToUnstructured(existing)
+	if err != nil {
+		return err
+	}
+	newFields, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return err
+	}
+
+	// only the status is written by UpdateStatus, and never by Update
+	updatedFields, statusFields := newFields, existingFields
+	if status {
+		updatedFields, statusFields = existingFields, newFields
+	}
+	delete(updatedFields, "status")
+	if statusVal, ok := statusFields["status"]; ok {
+		updatedFields["status"] = statusVal
+	}
+
+	updated, err := c.newObject(existing)
+	if err != nil {
+		return err
+	}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(updatedFields, updated); err != nil {
+		return err
+	}
+	// fields managed by the server
+	updated.SetUID(existing.GetUID())
+	updated.SetCreationTimestamp(existing.GetCreationTimestamp())
+	updated.SetDeletionTimestamp(existing.GetDeletionTimestamp())
+	updated.SetGeneration(existing.GetGeneration())
+	if !status && !equality.Semantic.DeepEqual(withoutMetadata(existingFields), withoutMetadata(updatedFields)) {
+		updated.SetGeneration(existing.GetGeneration() + 1)
+	}
+	updated.SetResourceVersion(c.nextResourceVersion())
+
+	// objects marked for deletion are deleted once their finalizers are removed
+	if updated.GetDeletionTimestamp() != nil && len(updated.GetFinalizers()) == 0 {
+		if err := c.tracker.Delete(gvr, obj.GetNamespace(), obj.GetName()); err != nil {
+			return err
+		}
+	} else if err := c.tracker.Update(gvr, updated, obj.GetNamespace()); err != nil {
+		return err
+	}
+	return copyInto(updated, obj)
+}
+
+// Delete deletes the object, or marks it for deletion if it has finalizers
+func (c *Client) Delete(ctx context.Context, obj ezkube.Object) error {
+	gvr, err := c.resourceFor(obj)
+	if err != nil {
+		return err
+	}
+	existingObj, err := c.tracker.Get(gvr, obj.GetNamespace(), obj.GetName())
+	if err != nil {
+		return err
+	}
+	existing := existingObj.(ezkube.Object)
+	if len(existing.GetFinalizers()) == 0 {
+		return c.tracker.Delete(gvr, obj.GetNamespace(), obj.GetName())
+	}
+	if existing.GetDeletionTimestamp() != nil {
+		return nil
+	}
+	now := metav1.Now()
+	existing.SetDeletionTimestamp(&now)
+	existing.SetResourceVersion(c.nextResourceVersion())
+	return c.tracker.Update(gvr, existing, obj.GetNamespace())
+}
+
+func (c *Client) Ensure(ctx context.Context, parent ezkube.Object, child ezkube.Object, reconcileFuncs ...ezkube.ReconcileFunc) (bool, error) {
+	if parent != nil {
+		if err := controllerruntime.SetControllerReference(parent, child, c.scheme); err != nil {
+			return false, err
+		}
+	}
+
+	orig := child.DeepCopyObject().(ezkube.Object)
+
+	for _, reconcile := range reconcileFuncs {
+		reconciledObj, err := reconcile(orig, child)
+		if err != nil {
+			return false, err
+		}
+		if reconciledObj == nil {
+			return false, nil
+		}
+		child = *reconciledObj
+	}
+
+	existing, err := c.newObject(child)
+	if err != nil {
+		return false, err
+	}
+	existing.SetNamespace(child.GetNamespace())
+	existing.SetName(child.GetName())
+
+	if err := c.Get(ctx, existing); err != nil {
+		if kubeerrs.IsNotFound(err) {
+			return true, c.Create(ctx, child)
+		}
+		return false, err
+	}
+
+	if matches, err := ezkube.ObjectMatches(child, existing); err != nil {
+		return false, err
+	} else if matches {
+		return false, nil
+	}
+
+	child.SetResourceVersion(existing.GetResourceVersion())
+	return true, c.Update(ctx, child)
+}
+
+func (c *Client) EnsureApplied(ctx context.Context, fieldManager string, parent ezkube.Object, child ezkube.Object) error {
+	if parent != nil {
+		if err := controllerruntime.SetControllerReference(parent, child, c.scheme); err != nil {
+			return err
+		}
+	}
+
+	existing, err := c.newObject(child)
+	if err != nil {
+		return err
+	}
+	existing.SetNamespace(child.GetNamespace())
+	existing.SetName(child.GetName())
+
+	if err := c.Get(ctx, existing); err != nil {
+		if kubeerrs.IsNotFound(err) {
+			child.SetResourceVersion("")
+			return c.Create(ctx, child)
+		}
+		return err
+	}
+
+	existingFields, err := runtime.DefaultUnstructuredConverter.ToUnstructured(existing)
+	if err != nil {
+		return err
+	}
+	childFields, err := runtime.DefaultUnstructuredConverter.ToUnstructured(child)
+	if err != nil {
+		return err
+	}
+	merged := mergeFields(existingFields, childFields).(map[string]interface{})
+
+	applied, err := c.newObject(existing)
+	if err != nil {
+		return err
+	}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(merged, applied); err != nil {
+		return err
+	}
+	applied.SetResourceVersion(existing.GetResourceVersion())
+	if err := c.Update(ctx, applied); err != nil {
+		return err
+	}
+	return copyInto(applied, child)
+}
+
+// the resource version assigned to the next write
+func (c *Client) nextResourceVersion() string {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.resourceVersion++
+	return strconv.FormatInt(c.resourceVersion, 10)
+}
+
+func (c *Client) resourceFor(obj runtime.Object) (schema.GroupVersionResource, error) {
+	gvk, err := apiutil.GVKForObject(obj, c.scheme)
+	if err != nil {
+		return schema.GroupVersionResource{}, err
+	}
+	gvr, _ := apimeta.UnsafeGuessKindToResource(gvk)
+	return gvr, nil
+}
+
+// newObject returns an empty object of the same type as obj
+func (c *Client) newObject(obj ezkube.Object) (ezkube.Object, error) {
+	gvk, err := apiutil.GVKForObject(obj, c.scheme)
+	if err != nil {
+		return nil, err
+	}
+	newObj, err := c.scheme.New(gvk)
+	if err != nil {
+		return nil, err
+	}
+	typed, ok := newObj.(ezkube.Object)
+	if !ok {
+		return nil, fmt.Errorf("%v is not an ezkube.Object", gvk)
+	}
+	return typed, nil
+}
+
+// copyInto replaces the contents of dst with those of src, which must be of the same type
+func copyInto(src, dst runtime.Object) error {
+	srcVal, dstVal := reflect.ValueOf(src), reflect.ValueOf(dst)
+	if srcVal.Type() != dstVal.Type() {
+		return fmt.Errorf("cannot copy %T into %T", src, dst)
+	}
+	dstVal.Elem().Set(reflect.ValueOf(src.DeepCopyObject()).Elem())
+	return nil
+}
+
+func withoutMetadata(fields map[string]interface{}) map[string]interface{} {
+	filtered := map[string]interface{}{}
+	for key, val := range fields {
+		if key != "metadata" && key != "status" {
+			filtered[key] = val
+		}
+	}
+	return filtered
+}
+
+// mergeFields merges the fields set in desired into existing.
+// maps are merged recursively, all other values (including lists) are replaced
+func mergeFields(existing, desired interface{}) interface{} {
+	desiredMap, ok := desired.(map[string]interface{})
+	if !ok {
+		if desired == nil {
+			return existing
+		}
+		return desired
+	}
+	existingMap, ok := existing.(map[string]interface{})
+	if !ok {
+		return desiredMap
+	}
+	merged := map[string]interface{}{}
+	for key, val := range existingMap {
+		merged[key] = val
+	}
+	for key, val := range desiredMap {
+		merged[key] = mergeFields(existingMap[key], val)
+	}
+	return merged
+}
+
+// listOptionsMatch returns true if the object matches the label and field selectors of the list options.
+// field selectors are supported on the name and namespace of the object and the UIDs of its owners
+func listOptionsMatch(opts *client.ListOptions, obj runtime.Object) (bool, error) {
+	meta, err := apimeta.Accessor(obj)
+	if err != nil {
+		return false, err
+	}
+	if opts.LabelSelector != nil && !opts.LabelSelector.Matches(labels.Set(meta.GetLabels())) {
+		return false, nil
+	}
+	if opts.FieldSelector == nil {
+		return true, nil
+	}
+	for _, req := range opts.FieldSelector.Requirements() {
+		var values []string
+		switch req.Field {
+		case "metadata.name":
+			values = []string{meta.GetName()}
+		case "metadata.namespace":
+			values = []string{meta.GetNamespace()}
+		case "metadata.ownerReferences.uid":
+			for _, ref := range meta.GetOwnerReferences() {
+				values = append(values, string(ref.UID))
+			}
+		default:
+			return false, fmt.Errorf("field selector on %v is not supported by the fake client", req.Field)
+		}
+		if contains(values, req.Value) == (req.Operator == selection.NotEquals) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,135 @@
+package fake_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/solo-io/autopilot/pkg/ezkube/fake"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("Client", func() {
+	var (
+		ctx = context.TODO()
+		c   *Client
+	)
+	BeforeEach(func() {
+		var err error
+		c, err = NewClientFromYaml(scheme.Scheme, `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: parent
+  namespace: default
+data:
+  some: data
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: petstore
+  namespace: default
+  labels:
+    app: petstore
+spec:
+  replicas: 1
+`)
+		Expect(err).NotTo(HaveOccurred())
+	})
+	It("reads objects seeded from yaml", func() {
+		parent := &v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "parent"}}
+		Expect(c.Get(ctx, parent)).To(Succeed())
+		Expect(parent.Data).To(Equal(map[string]string{"some": "data"}))
+		Expect(parent.UID).NotTo(BeEmpty())
+
+		var deployments appsv1.DeploymentList
+		Expect(c.List(ctx, &deployments, client.InNamespace("default"), client.MatchingLabels{"app": "petstore"})).To(Succeed())
+		Expect(deployments.Items).To(HaveLen(1))
+		Expect(c.List(ctx, &deployments, client.MatchingLabels{"app": "other"})).To(Succeed())
+		Expect(deployments.Items).To(BeEmpty())
+	})
+	It("ensures children with owner references", func() {
+		parent := &v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "parent"}}
+		Expect(c.Get(ctx, parent)).To(Succeed())
+
+		child := &v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "child"},
+			Data:       map[string]string{"smore": "data"},
+		}
+		written, err := c.Ensure(ctx, parent, child.DeepCopy())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(written).To(BeTrue())
+
+		actual := &v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "child"}}
+		Expect(c.Get(ctx, actual)).To(Succeed())
+		Expect(actual.Data).To(Equal(child.Data))
+		Expect(metav1.IsControlledBy(actual, parent)).To(BeTrue())
+
+		var owned v1.ConfigMapList
+		Expect(c.List(ctx, &owned, client.MatchingField("metadata.ownerReferences.uid", string(parent.UID)))).To(Succeed())
+		Expect(owned.Items).To(HaveLen(1))
+
+		// unchanged children are not written
+		written, err = c.Ensure(ctx, parent, child.DeepCopy())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(written).To(BeFalse())
+	})
+	It("keeps labels, annotations and finalizers added by other controllers", func() {
+		deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "petstore"}}
+		Expect(c.Get(ctx, deployment)).To(Succeed())
+		desired := deployment.DeepCopy()
+
+		deployment.Annotations = map[string]string{"deployment.kubernetes.io/revision": "1"}
+		deployment.Finalizers = []string{"example.io/finalizer"}
+		Expect(c.Update(ctx, deployment)).To(Succeed())
+
+		written, err := c.Ensure(ctx, nil, desired)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(written).To(BeFalse())
+
+		actual := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "petstore"}}
+		Expect(c.Get(ctx, actual)).To(Succeed())
+		Expect(actual.Annotations).To(HaveKeyWithValue("deployment.kubernetes.io/revision", "1"))
+		Expect(actual.Finalizers).To(ConsistOf("example.io/finalizer"))
+	})
+	It("updates the status separately from the rest of the object", func() {
+		deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "petstore"}}
+		Expect(c.Get(ctx, deployment)).To(Succeed())
+		Expect(deployment.Generation).To(Equal(int64(1)))
+
+		deployment.Status.ReadyReplicas = 1
+		Expect(c.UpdateStatus(ctx, deployment)).To(Succeed())
+		Expect(deployment.Generation).To(Equal(int64(1)))
+
+		deployment.Spec.Replicas = pointer.Int32Ptr(2)
+		deployment.Status.ReadyReplicas = 0
+		Expect(c.Update(ctx, deployment)).To(Succeed())
+		Expect(deployment.Generation).To(Equal(int64(2)))
+		Expect(deployment.Status.ReadyReplicas).To(Equal(int32(1)))
+
+		stale := deployment.DeepCopy()
+		stale.ResourceVersion = "1"
+		Expect(errors.IsConflict(c.Update(ctx, stale))).To(BeTrue())
+	})
+	It("deletes objects once their finalizers are removed", func() {
+		parent := &v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "parent"}}
+		Expect(c.Get(ctx, parent)).To(Succeed())
+		parent.Finalizers = []string{"example.io/finalizer"}
+		Expect(c.Update(ctx, parent)).To(Succeed())
+
+		Expect(c.Delete(ctx, parent)).To(Succeed())
+		Expect(c.Get(ctx, parent)).To(Succeed())
+		Expect(parent.DeletionTimestamp).NotTo(BeNil())
+
+		parent.Finalizers = nil
+		Expect(c.Update(ctx, parent)).To(Succeed())
+		Expect(errors.IsNotFound(c.Get(ctx, parent))).To(BeTrue())
+	})
+})
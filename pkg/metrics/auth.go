@@ -0,0 +1,235 @@
+package metrics
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/api"
+	v1 "github.com/solo-io/autopilot/api/v1"
+)
+
+// newRoundTripper builds the round tripper for the credentials and TLS settings of the metricsServer config.
+// credential files are read immediately, so invalid configuration is reported on startup
+func newRoundTripper(cfg *v1.MetricsServer) (http.RoundTripper, error) {
+	next := api.DefaultRoundTripper
+	if cfg.GetTls() != nil {
+		rt, err := newTLSRoundTripper(cfg.GetTls())
+		if err != nil {
+			return nil, err
+		}
+		next = rt
+	}
+
+	rt := &authRoundTripper{
+		basicAuth:   cfg.GetBasicAuth(),
+		bearerToken: cfg.GetBearerToken(),
+		next:        next,
+	}
+	if path := cfg.GetBasicAuth().GetPasswordFile(); path != "" {
+		rt.passwordFile = &reloadingFile{path: path}
+	}
+	if path := cfg.GetBearerTokenFile(); path != "" {
+		rt.bearerTokenFile = &reloadingFile{path: path}
+	}
+	if _, _, err := rt.credentials(); err != nil {
+		return nil, err
+	}
+	return rt, nil
+}
+
+// authRoundTripper adds the configured credentials to each request
+type authRoundTripper struct {
+	basicAuth       *v1.BasicAuth
+	passwordFile    *reloadingFile
+	bearerToken     string
+	bearerTokenFile *reloadingFile
+	next            http.RoundTripper
+}
+
+// the current password and bearer token
+func (rt *authRoundTripper) credentials() (string, string, error) {
+	password, bearerToken := rt.basicAuth.GetPassword(), rt.bearerToken
+	if rt.passwordFile != nil {
+		contents, _, err := rt.passwordFile.read()
+		if err != nil {
+			return "", "", errors.Wrapf(err, "reading basic auth password")
+		}
+		password = strings.TrimSpace(string(contents))
+	}
+	if rt.bearerTokenFile != nil {
+		contents, _, err := rt.bearerTokenFile.read()
+		if err != nil {
+			return "", "", errors.Wrapf(err, "reading bearer token")
+		}
+		bearerToken = strings.TrimSpace(string(contents))
+	}
+	return password, bearerToken, nil
+}
+
+func (rt *authRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if rt.basicAuth == nil && rt.bearerToken == "" && rt.bearerTokenFile == nil {
+		return rt.next.RoundTrip(req)
+	}
+	password, bearerToken, err := rt.credentials()
+	if err != nil {
+		return nil, err
+	}
+	// round trippers must not modify the original request
+	req = cloneRequest(req)
+	if rt.basicAuth != nil {
+		req.SetBasicAuth(rt.basicAuth.GetUsername(), password)
+	}
+	if bearerToken != "" {
+		req.Header.Set("Authorization", "Bearer "+bearerToken)
+	}
+	return rt.next.RoundTrip(req)
+}
+
+func cloneRequest(req *http.Request) *http.Request {
+	clone := new(http.Request)
+	*clone = *req
+	clone.Header = make(http.Header, len(req.Header))
+	for key, values := range req.Header {
+		clone.Header[key] = append([]string(nil), values...)
+	}
+	return clone
+}
+
+// tlsRoundTripper sends requests over a transport built from the TLS files,
+// rebuilding the transport when any of the files change
+type tlsRoundTripper struct {
+	cfg               *v1.MetricsServerTLS
+	caFile, cert, key *reloadingFile
+	lock              sync.Mutex
+	transport         *http.Transport
+}
+
+func newTLSRoundTripper(cfg *v1.MetricsServerTLS) (*tlsRoundTripper, error) {
+	if (cfg.GetCertFile() == "") != (cfg.GetKeyFile() == "") {
+		return nil, errors.Errorf("metricsServer tls requires both certFile and keyFile for mutual TLS")
+	}
+	rt := &tlsRoundTripper{cfg: cfg}
+	if cfg.GetCaFile() != "" {
+		rt.caFile = &reloadingFile{path: cfg.GetCaFile()}
+	}
+	if cfg.GetCertFile() != "" {
+		rt.cert = &reloadingFile{path: cfg.GetCertFile()}
+		rt.key = &reloadingFile{path: cfg.GetKeyFile()}
+	}
+	if _, err := rt.currentTransport(); err != nil {
+		return nil, err
+	}
+	return rt, nil
+}
+
+func (rt *tlsRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	transport, err := rt.currentTransport()
+	if err != nil {
+		return nil, err
+	}
+	return transport.RoundTrip(req)
+}
+
+// currentTransport returns the transport for the current contents of the TLS files
+func (rt *tlsRoundTripper) currentTransport() (*http.Transport, error) {
+	rt.lock.Lock()
+	defer rt.lock.Unlock()
+
+	var changed bool
+	var ca, cert, key []byte
+	for _, f := range []struct {
+		file     *reloadingFile
+		contents *[]byte
+	}{
+		{rt.caFile, &ca},
+		{rt.cert, &cert},
+		{rt.key, &key},
+	} {
+		if f.file == nil {
+			continue
+		}
+		contents, fileChanged, err := f.file.read()
+		if err != nil {
+			return nil, errors.Wrapf(err, "reading metricsServer tls file")
+		}
+		*f.contents = contents
+		changed = changed || fileChanged
+	}
+	if rt.transport != nil && !changed {
+		return rt.transport, nil
+	}
+
+	tlsConfig := &tls.Config{
+		ServerName:         rt.cfg.GetServerName(),
+		InsecureSkipVerify: rt.cfg.GetInsecureSkipVerify(),
+	}
+	if ca != nil {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, errors.Errorf("no certificates found in %v", rt.cfg.GetCaFile())
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if cert != nil {
+		keyPair, err := tls.X509KeyPair(cert, key)
+		if err != nil {
+			return nil, errors.Wrapf(err, "loading client certificate %v", rt.cfg.GetCertFile())
+		}
+		tlsConfig.Certificates = []tls.Certificate{keyPair}
+	}
+
+	// the same settings as the prometheus api.DefaultRoundTripper
+	transport := &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		TLSHandshakeTimeout: 10 * time.Second,
+		TLSClientConfig:     tlsConfig,
+	}
+	if rt.transport != nil {
+		rt.transport.CloseIdleConnections()
+	}
+	rt.transport = transport
+	return transport, nil
+}
+
+// reloadingFile caches the contents of a file, reading it again when its modification time or size changes.
+// mounted Secrets are updated by replacing a symlink, which changes the modification time of the target
+type reloadingFile struct {
+	path string
+
+	lock     sync.Mutex
+	modTime  time.Time
+	size     int64
+	contents []byte
+}
+
+// read returns the contents of the file, and true if they were (re)loaded
+func (f *reloadingFile) read() ([]byte, bool, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	info, err := os.Stat(f.path)
+	if err != nil {
+		return nil, false, err
+	}
+	if f.contents != nil && info.ModTime().Equal(f.modTime) && info.Size() == f.size {
+		return f.contents, false, nil
+	}
+	contents, err := ioutil.ReadFile(f.path)
+	if err != nil {
+		return nil, false, err
+	}
+	f.modTime, f.size, f.contents = info.ModTime(), info.Size(), contents
+	return contents, true, nil
+}
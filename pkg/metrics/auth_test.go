@@ -0,0 +1,88 @@
+package metrics_test
+
+import (
+	"context"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/autopilot/api/v1"
+	. "github.com/solo-io/autopilot/pkg/metrics"
+)
+
+var _ = Describe("Prometheus authentication", func() {
+	var (
+		dir         string
+		authHeaders []string
+		handler     http.HandlerFunc
+	)
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "metrics-auth")
+		Expect(err).NotTo(HaveOccurred())
+		authHeaders = nil
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			authHeaders = append(authHeaders, r.Header.Get("Authorization"))
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"status":"success","data":{"resultType":"scalar","result":[1,"42"]}}`))
+		}
+	})
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+	writeFile := func(name, contents string) string {
+		path := filepath.Join(dir, name)
+		Expect(ioutil.WriteFile(path, []byte(contents), 0600)).To(Succeed())
+		return path
+	}
+	query := func(client Client) {
+		result, err := client.RunQuery(context.TODO(), "up", nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Scalar()).To(Equal(42.0))
+	}
+
+	It("reloads the bearer token when the file changes", func() {
+		server := httptest.NewServer(handler)
+		defer server.Close()
+
+		tokenFile := writeFile("token", "first\n")
+		client, err := NewPrometheusClientWithConfig(server.URL, &v1.MetricsServer{BearerTokenFile: tokenFile})
+		Expect(err).NotTo(HaveOccurred())
+		query(client)
+
+		writeFile("token", "rotated\n")
+		query(client)
+
+		Expect(authHeaders).To(Equal([]string{"Bearer first", "Bearer rotated"}))
+	})
+	It("reports missing credential files on startup", func() {
+		_, err := NewPrometheusClientWithConfig("http://localhost:9090", &v1.MetricsServer{
+			BasicAuth: &v1.BasicAuth{Username: "admin", PasswordFile: filepath.Join(dir, "missing")},
+		})
+		Expect(err).To(HaveOccurred())
+	})
+	It("verifies the server with the CA file", func() {
+		server := httptest.NewTLSServer(handler)
+		defer server.Close()
+
+		caFile := writeFile("ca.crt", string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})))
+		client, err := NewPrometheusClientWithConfig(server.URL, &v1.MetricsServer{
+			BasicAuth: &v1.BasicAuth{Username: "admin", Password: "secret"},
+			Tls:       &v1.MetricsServerTLS{CaFile: caFile},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		query(client)
+		Expect(authHeaders).To(HaveLen(1))
+		Expect(authHeaders[0]).To(HavePrefix("Basic "))
+
+		untrusted, err := NewPrometheusClientWithConfig(server.URL, nil)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = untrusted.RunQuery(context.TODO(), "up", nil)
+		Expect(err).To(HaveOccurred())
+	})
+})
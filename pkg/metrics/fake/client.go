@@ -0,0 +1,104 @@
+// Package fake provides an in-memory implementation of metrics.Client
+// for unit-testing workers without a metrics server.
+package fake
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/solo-io/autopilot/pkg/metrics"
+)
+
+// Client is a metrics.Client which returns canned results for the queries it runs.
+// Queries which match no canned result return an empty vector.
+type Client struct {
+	lock    sync.Mutex
+	results []cannedResult
+	queries []string
+}
+
+type cannedResult struct {
+	substring string
+	result    *metrics.QueryResult
+	err       error
+}
+
+var _ metrics.Client = &Client{}
+
+func NewClient() *Client {
+	return &Client{}
+}
+
+// OnQuery returns the result for rendered queries containing the given substring (e.g. a metric name).
+// Results are matched in the order they were added
+func (c *Client) OnQuery(substring string, result *metrics.QueryResult) *Client {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.results = append(c.results, cannedResult{substring: substring, result: result})
+	return c
+}
+
+// OnQueryError returns the error for rendered queries containing the given substring
+func (c *Client) OnQueryError(substring string, err error) *Client {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.results = append(c.results, cannedResult{substring: substring, err: err})
+	return c
+}
+
+// Queries returns the rendered queries run by the Client, in order
+func (c *Client) Queries() []string {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return append([]string{}, c.queries...)
+}
+
+func (c *Client) RunQuery(ctx context.Context, queryTemplate string, data map[string]string) (*metrics.QueryResult, error) {
+	return c.run(queryTemplate, data, model.Vector{})
+}
+
+// RunRangeQuery returns the same canned results as RunQuery, regardless of the range.
+// Queries which match no canned result return an empty matrix.
+func (c *Client) RunRangeQuery(ctx context.Context, queryTemplate string, data map[string]string, start, end time.Time, step time.Duration) (*metrics.QueryResult, error) {
+	return c.run(queryTemplate, data, model.Matrix{})
+}
+
+func (c *Client) run(queryTemplate string, data map[string]string, empty model.Value) (*metrics.QueryResult, error) {
+	query, err := metrics.RenderQuery(queryTemplate, data)
+	if err != nil {
+		return nil, err
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.queries = append(c.queries, query)
+	for _, canned := range c.results {
+		if strings.Contains(query, canned.substring) {
+			return canned.result, canned.err
+		}
+	}
+	return &metrics.QueryResult{Value: empty}, nil
+}
+
+// Sample returns a QueryResult containing a single sample with the given value
+func Sample(value float64) *metrics.QueryResult {
+	return &metrics.QueryResult{Value: model.Vector{
+		&model.Sample{Value: model.SampleValue(value)},
+	}}
+}
+
+// Series returns a QueryResult containing a single series with the given values,
+// one per step starting at the given time
+func Series(start time.Time, step time.Duration, values ...float64) *metrics.QueryResult {
+	stream := &model.SampleStream{}
+	for i, value := range values {
+		stream.Values = append(stream.Values, model.SamplePair{
+			Timestamp: model.TimeFromUnixNano(start.Add(time.Duration(i) * step).UnixNano()),
+			Value:     model.SampleValue(value),
+		})
+	}
+	return &metrics.QueryResult{Value: model.Matrix{stream}}
+}
@@ -6,41 +6,92 @@ import (
 	"html/template"
 	"time"
 
+	"github.com/golang/protobuf/ptypes"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/api"
 	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	apiv1 "github.com/solo-io/autopilot/api/v1"
 )
 
 // A generic interface for interacting with Metrics stores.
 type Client interface {
+	// run an instant query at the current time
 	RunQuery(ctx context.Context, queryTemplate string, data map[string]string) (*QueryResult, error)
+
+	// run a query over the range from start to end, evaluated at every step.
+	// the result of a range query is a matrix with a sample for each step
+	RunRangeQuery(ctx context.Context, queryTemplate string, data map[string]string, start, end time.Time, step time.Duration) (*QueryResult, error)
 }
 
 type promClient struct {
 	v1.API
+
+	// the timeout for each query, 0 for none
+	timeout time.Duration
 }
 
 // returns a client for running queries against Prometheus
 func NewPrometheusClient(addr string) (*promClient, error) {
+	return NewPrometheusClientWithConfig(addr, nil)
+}
+
+// returns a client for running queries against Prometheus with the timeout, credentials and TLS settings of the metricsServer config.
+// the url and discovery of the config are ignored in favor of the given address
+func NewPrometheusClientWithConfig(addr string, cfg *apiv1.MetricsServer) (*promClient, error) {
+	var timeout time.Duration
+	if cfg.GetTimeout() != nil {
+		var err error
+		timeout, err = ptypes.Duration(cfg.GetTimeout())
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid metricsServer timeout")
+		}
+	}
+	roundTripper, err := newRoundTripper(cfg)
+	if err != nil {
+		return nil, err
+	}
 	client, err := api.NewClient(api.Config{
-		Address: addr,
+		Address:      addr,
+		RoundTripper: roundTripper,
 	})
 	if err != nil {
 		return nil, err
 	}
-	return &promClient{API: v1.NewAPI(client)}, nil
+	return &promClient{API: v1.NewAPI(client), timeout: timeout}, nil
+}
+
+// withTimeout applies the query timeout to the context
+func (c *promClient) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if c.timeout == 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, c.timeout)
 }
 
 func (c *promClient) RunQuery(ctx context.Context, queryTemplate string, data map[string]string) (*QueryResult, error) {
-	query, err := renderQuery(queryTemplate, data)
+	query, err := RenderQuery(queryTemplate, data)
 	if err != nil {
 		return nil, errors.Wrapf(err, "rendering query")
 	}
+	ctx, cancel := c.withTimeout(ctx)
+	defer cancel()
 	value, _, err := c.API.Query(ctx, query, time.Now())
 	return &QueryResult{Value: value}, err
 }
 
-func renderQuery(queryTemplate string, data map[string]string) (string, error) {
+func (c *promClient) RunRangeQuery(ctx context.Context, queryTemplate string, data map[string]string, start, end time.Time, step time.Duration) (*QueryResult, error) {
+	query, err := RenderQuery(queryTemplate, data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "rendering query")
+	}
+	ctx, cancel := c.withTimeout(ctx)
+	defer cancel()
+	value, _, err := c.API.QueryRange(ctx, query, v1.Range{Start: start, End: end, Step: step})
+	return &QueryResult{Value: value}, err
+}
+
+// RenderQuery executes the query template with the given parameters
+func RenderQuery(queryTemplate string, data map[string]string) (string, error) {
 	tmpl := template.Must(template.New("query").Parse(queryTemplate))
 	buf := &bytes.Buffer{}
 	err := tmpl.Execute(buf, data)
@@ -1,19 +1,83 @@
 package metrics
 
 import (
+	"context"
 	"fmt"
 	"os"
 
+	"github.com/pkg/errors"
 	v1 "github.com/solo-io/autopilot/api/v1"
+	"github.com/solo-io/autopilot/pkg/config"
+	"github.com/solo-io/autopilot/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-func GetMetricsServerAddr(meshProvider v1.MeshProvider, controlPlaneNs string) string {
+// NewMetricsClient returns a client for the metrics server of the operator's mesh provider
+func NewMetricsClient(ctx context.Context, reader client.Reader, cfg *v1.AutopilotOperator) (Client, error) {
+	if cfg.GetMetricsServer().GetBearerToken() != "" || cfg.GetMetricsServer().GetBasicAuth().GetPassword() != "" {
+		utils.LoggerFromContext(ctx).Info("metricsServer.bearerToken and metricsServer.basicAuth.password are deprecated " +
+			"as they store credentials in plain text, use bearerTokenFile and passwordFile with a mounted Secret instead")
+	}
+	addr, err := GetMetricsServerAddr(ctx, reader, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return NewPrometheusClientWithConfig(addr, cfg.GetMetricsServer())
+}
+
+// GetMetricsServerAddr returns the address of the metrics server, in order of precedence:
+// the METRICS_SERVER environment variable, the url of the metricsServer,
+// the address discovered for the metricsServer, or the default server of the mesh provider
+func GetMetricsServerAddr(ctx context.Context, reader client.Reader, cfg *v1.AutopilotOperator) (string, error) {
 	if metricsServer := os.Getenv("METRICS_SERVER"); metricsServer != "" {
-		return metricsServer
+		return metricsServer, nil
+	}
+	if url := cfg.GetMetricsServer().GetUrl(); url != "" {
+		return url, nil
 	}
-	switch meshProvider {
+	if discovery := cfg.GetMetricsServer().GetDiscovery(); discovery != nil {
+		return discoverPrometheus(ctx, reader, discovery, config.ControlPlaneNamespace(cfg), cfg.GetMetricsServer().GetTls() != nil)
+	}
+	switch cfg.GetMeshProvider() {
 	case v1.MeshProvider_Istio:
-		return fmt.Sprintf("http://prometheus.%v:9090", controlPlaneNs)
+		return fmt.Sprintf("http://prometheus.%v:9090", config.ControlPlaneNamespace(cfg)), nil
+	case v1.MeshProvider_Linkerd:
+		return fmt.Sprintf("http://linkerd-prometheus.%v:9090", config.ControlPlaneNamespace(cfg)), nil
+	case v1.MeshProvider_Custom:
+		return "", errors.Errorf("the %v mesh provider requires metricsServer.url or metricsServer.discovery", cfg.GetMeshProvider())
+	}
+	return "", errors.Errorf("unsupported mesh provider %v", cfg.GetMeshProvider())
+}
+
+// discoverPrometheus returns the address of the single Service matching the discovery labels
+func discoverPrometheus(ctx context.Context, reader client.Reader, discovery *v1.PrometheusDiscovery, controlPlaneNs string, useTLS bool) (string, error) {
+	namespace := discovery.GetNamespace()
+	if namespace == "" {
+		namespace = controlPlaneNs
+	}
+	if len(discovery.GetLabels()) == 0 {
+		return "", errors.Errorf("metricsServer.discovery requires labels")
+	}
+
+	var services corev1.ServiceList
+	if err := reader.List(ctx, &services, client.InNamespace(namespace), client.MatchingLabels(discovery.GetLabels())); err != nil {
+		return "", errors.Wrapf(err, "discovering Prometheus in namespace %v", namespace)
+	}
+	if len(services.Items) != 1 {
+		return "", errors.Errorf("expected one Prometheus Service in namespace %v with labels %v, found %v", namespace, discovery.GetLabels(), len(services.Items))
+	}
+	service := services.Items[0]
+
+	scheme := "http"
+	if useTLS {
+		scheme = "https"
+	}
+
+	for _, port := range service.Spec.Ports {
+		if discovery.GetPort() == "" || port.Name == discovery.GetPort() {
+			return fmt.Sprintf("%v://%v.%v:%v", scheme, service.Name, service.Namespace, port.Port), nil
+		}
 	}
-	panic("currently unsupported: " + meshProvider.String())
+	return "", errors.Errorf("Prometheus Service %v.%v has no port %q", service.Namespace, service.Name, discovery.GetPort())
 }
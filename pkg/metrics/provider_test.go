@@ -0,0 +1,74 @@
+package metrics_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/autopilot/api/v1"
+	. "github.com/solo-io/autopilot/pkg/metrics"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("GetMetricsServerAddr", func() {
+	prometheus := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "prometheus-server",
+			Namespace: "monitoring",
+			Labels:    map[string]string{"app": "prometheus"},
+		},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{{Name: "grpc", Port: 9091}, {Name: "http", Port: 9090}},
+		},
+	}
+	reader := fake.NewFakeClientWithScheme(scheme.Scheme, prometheus)
+
+	It("uses the default server of the Istio provider", func() {
+		addr, err := GetMetricsServerAddr(context.TODO(), reader, &v1.AutopilotOperator{
+			MeshProvider:   v1.MeshProvider_Istio,
+			ControlPlaneNs: "istio-system",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(addr).To(Equal("http://prometheus.istio-system:9090"))
+	})
+	It("uses the Prometheus of the Linkerd control plane", func() {
+		addr, err := GetMetricsServerAddr(context.TODO(), reader, &v1.AutopilotOperator{MeshProvider: v1.MeshProvider_Linkerd})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(addr).To(Equal("http://linkerd-prometheus.linkerd:9090"))
+	})
+	It("requires an explicit server for the Custom provider", func() {
+		_, err := GetMetricsServerAddr(context.TODO(), reader, &v1.AutopilotOperator{MeshProvider: v1.MeshProvider_Custom})
+		Expect(err).To(HaveOccurred())
+
+		addr, err := GetMetricsServerAddr(context.TODO(), reader, &v1.AutopilotOperator{
+			MeshProvider:  v1.MeshProvider_Custom,
+			MetricsServer: &v1.MetricsServer{Url: "https://prometheus.example.com"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(addr).To(Equal("https://prometheus.example.com"))
+	})
+	It("discovers Prometheus by the labels of its Service", func() {
+		addr, err := GetMetricsServerAddr(context.TODO(), reader, &v1.AutopilotOperator{
+			MeshProvider: v1.MeshProvider_Custom,
+			MetricsServer: &v1.MetricsServer{Discovery: &v1.PrometheusDiscovery{
+				Namespace: "monitoring",
+				Labels:    map[string]string{"app": "prometheus"},
+				Port:      "http",
+			}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(addr).To(Equal("http://prometheus-server.monitoring:9090"))
+
+		_, err = GetMetricsServerAddr(context.TODO(), reader, &v1.AutopilotOperator{
+			MeshProvider: v1.MeshProvider_Custom,
+			MetricsServer: &v1.MetricsServer{Discovery: &v1.PrometheusDiscovery{
+				Namespace: "monitoring",
+				Labels:    map[string]string{"app": "thanos"},
+			}},
+		})
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -1,10 +1,111 @@
 package metrics
 
-import "github.com/prometheus/common/model"
+import (
+	"math"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/common/model"
+)
+
+// returned by QueryResult accessors when the query returned no data
+var ErrNoData = errors.New("query returned no data")
 
 // the result of a generic metrics query
 // a shim for any value that can be returned by PromQL
+//
+// The accessors of a QueryResult treat NaN samples as missing data.
+// Prometheus returns NaN for ratios over no data, e.g. a success rate while a service receives no requests.
+// Range (matrix) results are reduced to the latest value of each series.
 type QueryResult struct {
 	// value returned is a prometheus query result
 	model.Value
 }
+
+// IsEmpty returns true if the result contains no samples other than NaN
+func (r *QueryResult) IsEmpty() bool {
+	samples, err := r.samples()
+	return err != nil || len(samples) == 0
+}
+
+// Scalar returns the value of a result which contains exactly one sample, such as a scalar or an aggregated vector.
+// Returns ErrNoData if the result is empty, and an error if it contains more than one sample
+func (r *QueryResult) Scalar() (float64, error) {
+	samples, err := r.samples()
+	if err != nil {
+		return 0, err
+	}
+	switch len(samples) {
+	case 0:
+		return 0, ErrNoData
+	case 1:
+		return samples[0].value, nil
+	default:
+		return 0, errors.Errorf("expected a single sample, query returned %v", len(samples))
+	}
+}
+
+// FirstSample returns the value of the first sample in the result.
+// Returns ErrNoData if the result is empty
+func (r *QueryResult) FirstSample() (float64, error) {
+	samples, err := r.samples()
+	if err != nil {
+		return 0, err
+	}
+	if len(samples) == 0 {
+		return 0, ErrNoData
+	}
+	return samples[0].value, nil
+}
+
+// ByLabel returns the values of the samples in the result keyed by the value of the given label.
+// Samples without the label are keyed by the empty string.
+// If multiple samples have the same label value, the last one wins
+func (r *QueryResult) ByLabel(name string) map[string]float64 {
+	values := map[string]float64{}
+	samples, err := r.samples()
+	if err != nil {
+		return values
+	}
+	for _, s := range samples {
+		values[string(s.metric[model.LabelName(name)])] = s.value
+	}
+	return values
+}
+
+type sample struct {
+	metric model.Metric
+	value  float64
+}
+
+// the non-NaN samples in the result
+func (r *QueryResult) samples() ([]sample, error) {
+	if r == nil || r.Value == nil {
+		return nil, nil
+	}
+	var samples []sample
+	add := func(metric model.Metric, value model.SampleValue) {
+		if math.IsNaN(float64(value)) {
+			return
+		}
+		samples = append(samples, sample{metric: metric, value: float64(value)})
+	}
+	switch val := r.Value.(type) {
+	case *model.Scalar:
+		if val != nil {
+			add(nil, val.Value)
+		}
+	case model.Vector:
+		for _, s := range val {
+			add(s.Metric, s.Value)
+		}
+	case model.Matrix:
+		for _, stream := range val {
+			if len(stream.Values) > 0 {
+				add(stream.Metric, stream.Values[len(stream.Values)-1].Value)
+			}
+		}
+	default:
+		return nil, errors.Errorf("unsupported query result type %T", val)
+	}
+	return samples, nil
+}
@@ -0,0 +1,49 @@
+package metrics_test
+
+import (
+	"math"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/common/model"
+	. "github.com/solo-io/autopilot/pkg/metrics"
+)
+
+var _ = Describe("QueryResult", func() {
+	sample := func(pod string, value float64) *model.Sample {
+		return &model.Sample{Metric: model.Metric{"pod": model.LabelValue(pod)}, Value: model.SampleValue(value)}
+	}
+	It("returns the value of scalars and single-sample vectors", func() {
+		Expect((&QueryResult{Value: &model.Scalar{Value: 99}}).Scalar()).To(Equal(99.0))
+		Expect((&QueryResult{Value: model.Vector{sample("a", 98)}}).Scalar()).To(Equal(98.0))
+
+		_, err := (&QueryResult{Value: model.Vector{sample("a", 1), sample("b", 2)}}).Scalar()
+		Expect(err).To(HaveOccurred())
+		Expect((&QueryResult{Value: model.Vector{sample("a", 1), sample("b", 2)}}).FirstSample()).To(Equal(1.0))
+	})
+	It("treats empty results and NaN samples as no data", func() {
+		for _, result := range []*QueryResult{
+			{},
+			{Value: model.Vector{}},
+			{Value: model.Vector{sample("a", math.NaN())}},
+			{Value: &model.Scalar{Value: model.SampleValue(math.NaN())}},
+		} {
+			Expect(result.IsEmpty()).To(BeTrue())
+			_, err := result.Scalar()
+			Expect(err).To(Equal(ErrNoData))
+			_, err = result.FirstSample()
+			Expect(err).To(Equal(ErrNoData))
+		}
+	})
+	It("keys samples by label, using the latest value of range results", func() {
+		vector := &QueryResult{Value: model.Vector{sample("a", 1), sample("b", math.NaN()), sample("c", 3)}}
+		Expect(vector.ByLabel("pod")).To(Equal(map[string]float64{"a": 1, "c": 3}))
+
+		matrix := &QueryResult{Value: model.Matrix{{
+			Metric: model.Metric{"pod": "a"},
+			Values: []model.SamplePair{{Timestamp: 0, Value: 1}, {Timestamp: 30, Value: 2}},
+		}}}
+		Expect(matrix.ByLabel("pod")).To(Equal(map[string]float64{"a": 2}))
+		Expect(matrix.Scalar()).To(Equal(2.0))
+	})
+})
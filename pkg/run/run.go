@@ -50,6 +50,15 @@ func RegisterAddToScheme(s func(scheme *runtime.Scheme) error) {
 	schemeBuilder = append(schemeBuilder, s)
 }
 
+// NewScheme returns a scheme containing the Kubernetes types and all types registered with RegisterAddToScheme
+func NewScheme() (*runtime.Scheme, error) {
+	scheme := runtime.NewScheme()
+	if err := schemeBuilder.AddToScheme(scheme); err != nil {
+		return nil, err
+	}
+	return scheme, nil
+}
+
 // Bootstrap config for the Run function
 type Options struct {
 	// root context for the operator. cancel this to shutdown gracefully
@@ -67,9 +76,8 @@ func Run(addToManager AddToManager) error {
 	logger := logf.Log
 
 	// initialize scheme
-	scheme := runtime.NewScheme()
-
-	if err := schemeBuilder.AddToScheme(scheme); err != nil {
+	scheme, err := NewScheme()
+	if err != nil {
 		return err
 	}
 
@@ -277,12 +285,27 @@ func (instance *operatorInstance) Start() error {
 	// ours starts with 0 for debug
 	logLevel.SetLevel(zapcore.Level(level - 1))
 
+	// the webhook server is only started if the scheduler registers webhooks with the manager
+	webhookPort := int(instance.config.WebhookPort)
+	if webhookPort == 0 {
+		webhookPort = defaults.WebhookPort
+	}
+	webhookCertDir := instance.config.WebhookCertDir
+	if webhookCertDir == "" {
+		webhookCertDir = defaults.WebhookCertDir
+	}
+
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
 		Scheme:                  instance.scheme,
 		MetricsBindAddress:      instance.config.MetricsAddr,
 		LeaderElection:          enableLeaderElection,
 		LeaderElectionNamespace: leaderElectionNamespace,
-		// TODO: webhook support
+		Port:                    webhookPort,
+		CertDir:                 webhookCertDir,
+
+		// a namespace-scoped operator caches only its watched namespace.
+		// the scheduler reads inputs scoped outside of it from the API server
+		Namespace: instance.config.WatchNamespace,
 	})
 	if err != nil {
 		return err
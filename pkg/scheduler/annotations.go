@@ -0,0 +1,34 @@
+package scheduler
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// while this annotation is set on the top-level CRD (to any value other than "false"),
+	// the scheduler will not run workers for the CRD
+	PausedAnnotation = "autopilot.solo.io/paused"
+
+	// when this annotation is set on the top-level CRD, the scheduler removes the annotation
+	// and transitions the CRD to the named phase, if the phase graph permits the transition
+	ForcePhaseAnnotation = "autopilot.solo.io/force-phase"
+)
+
+// IsPaused returns true if the object has the paused annotation
+func IsPaused(obj metav1.Object) bool {
+	paused, ok := obj.GetAnnotations()[PausedAnnotation]
+	return ok && paused != "false"
+}
+
+// ForcedPhase returns the phase requested by the force-phase annotation, if present
+func ForcedPhase(obj metav1.Object) (string, bool) {
+	phase, ok := obj.GetAnnotations()[ForcePhaseAnnotation]
+	return phase, ok
+}
+
+// RemoveForcedPhase removes the force-phase annotation from the object
+func RemoveForcedPhase(obj metav1.Object) {
+	annotations := obj.GetAnnotations()
+	delete(annotations, ForcePhaseAnnotation)
+	obj.SetAnnotations(annotations)
+}
@@ -0,0 +1,38 @@
+package scheduler
+
+import (
+	"math"
+	"time"
+)
+
+// Backoff computes the interval the scheduler waits before retrying a failing worker
+type Backoff struct {
+	// the interval to wait after the first failure
+	InitialInterval time.Duration
+
+	// the maximum interval to wait, 0 if capped only by the longest time.Duration
+	MaxInterval time.Duration
+
+	// the factor by which the interval grows after each consecutive failure
+	Multiplier float64
+}
+
+// the longest interval which can be represented by a time.Duration
+const maxDuration = time.Duration(math.MaxInt64)
+
+// Interval returns the interval to wait after the given number of consecutive failures.
+// without a MaxInterval, the interval is capped at the longest representable time.Duration
+func (b Backoff) Interval(failures int32) time.Duration {
+	maxInterval := b.MaxInterval
+	if maxInterval <= 0 {
+		maxInterval = maxDuration
+	}
+	interval := float64(b.InitialInterval)
+	for i := int32(1); i < failures; i++ {
+		interval *= b.Multiplier
+		if interval >= float64(maxInterval) {
+			return maxInterval
+		}
+	}
+	return time.Duration(interval)
+}
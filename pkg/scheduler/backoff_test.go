@@ -0,0 +1,34 @@
+package scheduler_test
+
+import (
+	"math"
+	"time"
+
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	. "github.com/solo-io/autopilot/pkg/scheduler"
+)
+
+var _ = DescribeTable("Backoff.Interval",
+	func(backoff Backoff, failures int32, expected time.Duration) {
+		Expect(backoff.Interval(failures)).To(Equal(expected))
+	},
+	Entry("waits the initial interval after the first failure",
+		Backoff{InitialInterval: time.Second, MaxInterval: time.Minute, Multiplier: 2}, int32(1), time.Second),
+	Entry("grows by the multiplier after each consecutive failure",
+		Backoff{InitialInterval: time.Second, MaxInterval: time.Minute, Multiplier: 2}, int32(4), 8*time.Second),
+	Entry("supports fractional multipliers",
+		Backoff{InitialInterval: time.Second, MaxInterval: time.Minute, Multiplier: 1.5}, int32(3), 2250*time.Millisecond),
+	Entry("is capped at the max interval",
+		Backoff{InitialInterval: time.Second, MaxInterval: 10 * time.Second, Multiplier: 2}, int32(5), 10*time.Second),
+	Entry("stays at the max interval after many failures",
+		Backoff{InitialInterval: time.Second, MaxInterval: 10 * time.Second, Multiplier: 2}, int32(1000), 10*time.Second),
+	Entry("is uncapped without a max interval",
+		Backoff{InitialInterval: time.Second, Multiplier: 2}, int32(11), 1024*time.Second),
+	Entry("does not overflow without a max interval",
+		Backoff{InitialInterval: time.Second, Multiplier: 2}, int32(1000), time.Duration(math.MaxInt64)),
+	Entry("does not overflow with a large multiplier",
+		Backoff{InitialInterval: time.Hour, Multiplier: 1e12}, int32(3), time.Duration(math.MaxInt64)),
+	Entry("is constant with a multiplier of 1",
+		Backoff{InitialInterval: 5 * time.Second, MaxInterval: time.Minute, Multiplier: 1}, int32(20), 5*time.Second),
+)
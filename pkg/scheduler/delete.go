@@ -0,0 +1,36 @@
+package scheduler
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/solo-io/autopilot/pkg/ezkube"
+	kubeerrs "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+)
+
+// DeleteOutputs deletes each object in the list of deletions returned by a worker.
+// Objects which no longer exist are ignored, so deletions may be returned on every sync
+func DeleteOutputs(ctx context.Context, client ezkube.Client, deletions ezkube.List) error {
+	items, err := apimeta.ExtractList(deletions)
+	if err != nil {
+		return err
+	}
+	for _, item := range items {
+		obj, ok := item.(ezkube.Object)
+		if !ok {
+			return errors.Errorf("cannot delete object of type %T", item)
+		}
+		if err := deleteIgnoreNotFound(ctx, client, obj); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func deleteIgnoreNotFound(ctx context.Context, client ezkube.Client, obj ezkube.Object) error {
+	if err := client.Delete(ctx, obj); err != nil && !kubeerrs.IsNotFound(err) {
+		return errors.Wrapf(err, "deleting %v.%v", obj.GetNamespace(), obj.GetName())
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package scheduler_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"github.com/solo-io/autopilot/pkg/ezkube"
+	"github.com/solo-io/autopilot/pkg/ezkube/fake"
+	. "github.com/solo-io/autopilot/pkg/scheduler"
+	corev1 "k8s.io/api/core/v1"
+	kubeerrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/scheme"
+)
+
+var _ = Describe("DeleteOutputs", func() {
+	var (
+		ctx    = context.TODO()
+		client *fake.Client
+	)
+	BeforeEach(func() {
+		var err error
+		client, err = fake.NewClient(scheme.Scheme, configMap("a"), configMap("b"), configMap("c"))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	DescribeTable("deletes the returned deletions",
+		func(deletions []string, remaining []string) {
+			Expect(DeleteOutputs(ctx, client, configMaps(deletions...))).To(Succeed())
+			Expect(configMapNames(client)).To(Equal(remaining))
+		},
+		Entry("nothing", nil, []string{"a", "b", "c"}),
+		Entry("existing objects", []string{"a", "c"}, []string{"b"}),
+		Entry("objects which no longer exist", []string{"b", "d"}, []string{"a", "c"}),
+		Entry("the same object twice", []string{"a", "a"}, []string{"b", "c"}),
+	)
+
+	It("returns errors other than not found", func() {
+		err := DeleteOutputs(ctx, &forbiddenDeletes{client}, configMaps("b", "c"))
+		Expect(err).To(HaveOccurred())
+		Expect(kubeerrs.IsForbidden(errors.Cause(err))).To(BeTrue())
+		Expect(configMapNames(client)).To(Equal([]string{"a", "b", "c"}))
+	})
+})
+
+// a client which is forbidden from deleting objects
+type forbiddenDeletes struct {
+	*fake.Client
+}
+
+func (c *forbiddenDeletes) Delete(ctx context.Context, obj ezkube.Object) error {
+	return kubeerrs.NewForbidden(schema.GroupResource{Resource: "configmaps"}, obj.GetName(), nil)
+}
+
+func configMap(name string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}}
+}
+
+func configMaps(names ...string) *corev1.ConfigMapList {
+	list := &corev1.ConfigMapList{}
+	for _, name := range names {
+		list.Items = append(list.Items, *configMap(name))
+	}
+	return list
+}
+
+// the names of the config maps which exist in the client
+func configMapNames(client ezkube.Client) []string {
+	list := &corev1.ConfigMapList{}
+	Expect(client.List(context.TODO(), list)).To(Succeed())
+	var names []string
+	for _, item := range list.Items {
+		names = append(names, item.Name)
+	}
+	return names
+}
@@ -0,0 +1,98 @@
+package scheduler
+
+import (
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctl "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// the field by which inputs are indexed by name.
+	// enables field selectors such as `metadata.name={{ .Name }}` for phase inputs
+	NameField = "metadata.name"
+
+	// the field by which inputs are indexed by the UIDs of their owners.
+	// enables field selectors such as `metadata.ownerReferences.uid={{ .UID }}` for phase inputs
+	OwnerField = "metadata.ownerReferences.uid"
+)
+
+// RegisterInputIndexes registers the field indexes for inputs of the given type with the manager's cache
+func RegisterInputIndexes(indexer ctl.FieldIndexer, obj runtime.Object) error {
+	if err := indexer.IndexField(obj, NameField, func(obj runtime.Object) []string {
+		meta, err := apimeta.Accessor(obj)
+		if err != nil {
+			return nil
+		}
+		return []string{meta.GetName()}
+	}); err != nil {
+		return err
+	}
+	return indexer.IndexField(obj, OwnerField, func(obj runtime.Object) []string {
+		meta, err := apimeta.Accessor(obj)
+		if err != nil {
+			return nil
+		}
+		var owners []string
+		for _, ref := range meta.GetOwnerReferences() {
+			owners = append(owners, string(ref.UID))
+		}
+		return owners
+	})
+}
+
+// InputIndex maps the name, labels and owners of the items of an input list to their positions in the list.
+// it is used by the generated Inputs to look up items without scanning the list
+type InputIndex struct {
+	len     int
+	byName  map[types.NamespacedName]int
+	byLabel map[string][]int
+	byOwner map[types.UID][]int
+}
+
+// NewInputIndex indexes the n items returned by item
+func NewInputIndex(n int, item func(i int) metav1.Object) *InputIndex {
+	idx := &InputIndex{
+		len:     n,
+		byName:  make(map[types.NamespacedName]int, n),
+		byLabel: map[string][]int{},
+		byOwner: map[types.UID][]int{},
+	}
+	for i := 0; i < n; i++ {
+		obj := item(i)
+		idx.byName[types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}] = i
+		for key, value := range obj.GetLabels() {
+			idx.byLabel[labelKey(key, value)] = append(idx.byLabel[labelKey(key, value)], i)
+		}
+		for _, ref := range obj.GetOwnerReferences() {
+			idx.byOwner[ref.UID] = append(idx.byOwner[ref.UID], i)
+		}
+	}
+	return idx
+}
+
+// Len returns the number of items which were indexed
+func (idx *InputIndex) Len() int {
+	return idx.len
+}
+
+// Find returns the position of the named item
+func (idx *InputIndex) Find(name, namespace string) (int, bool) {
+	i, ok := idx.byName[types.NamespacedName{Namespace: namespace, Name: name}]
+	return i, ok
+}
+
+// ByLabel returns the positions of the items with the given label value
+func (idx *InputIndex) ByLabel(key, value string) []int {
+	return idx.byLabel[labelKey(key, value)]
+}
+
+// OwnedBy returns the positions of the items owned by the object with the given UID
+func (idx *InputIndex) OwnedBy(owner types.UID) []int {
+	return idx.byOwner[owner]
+}
+
+func labelKey(key, value string) string {
+	return key + "=" + value
+}
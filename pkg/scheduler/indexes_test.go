@@ -0,0 +1,99 @@
+package scheduler_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	. "github.com/solo-io/autopilot/pkg/scheduler"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctl "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("InputIndex", func() {
+	pods := []corev1.Pod{
+		*indexedPod("a", "default", map[string]string{"app": "canary"}, "owner-1"),
+		*indexedPod("b", "default", map[string]string{"app": "canary", "version": "v1"}, "owner-1", "owner-2"),
+		*indexedPod("a", "other", map[string]string{"app": "primary"}),
+	}
+	var idx *InputIndex
+	BeforeEach(func() {
+		idx = NewInputIndex(len(pods), func(i int) metav1.Object {
+			return &pods[i]
+		})
+	})
+
+	It("counts the indexed items", func() {
+		Expect(idx.Len()).To(Equal(3))
+	})
+
+	DescribeTable("Find",
+		func(name, namespace string, expected int, found bool) {
+			i, ok := idx.Find(name, namespace)
+			Expect(ok).To(Equal(found))
+			Expect(i).To(Equal(expected))
+		},
+		Entry("an item", "b", "default", 1, true),
+		Entry("an item with the same name in another namespace", "a", "other", 2, true),
+		Entry("a missing name", "c", "default", 0, false),
+		Entry("a missing namespace", "b", "other", 0, false),
+	)
+
+	DescribeTable("ByLabel",
+		func(key, value string, expected []int) {
+			Expect(idx.ByLabel(key, value)).To(Equal(expected))
+		},
+		Entry("a value shared by several items", "app", "canary", []int{0, 1}),
+		Entry("a value held by one item", "version", "v1", []int{1}),
+		Entry("a value held by no items", "app", "other", nil),
+		Entry("a missing key", "tier", "canary", nil),
+	)
+
+	DescribeTable("OwnedBy",
+		func(owner types.UID, expected []int) {
+			Expect(idx.OwnedBy(owner)).To(Equal(expected))
+		},
+		Entry("an owner of several items", types.UID("owner-1"), []int{0, 1}),
+		Entry("an owner of one item", types.UID("owner-2"), []int{1}),
+		Entry("an unknown owner", types.UID("owner-3"), nil),
+	)
+})
+
+var _ = Describe("RegisterInputIndexes", func() {
+	It("indexes inputs by name and owners", func() {
+		indexer := fieldIndexer{}
+		Expect(RegisterInputIndexes(indexer, &corev1.Pod{})).To(Succeed())
+		Expect(indexer).To(HaveLen(2))
+
+		obj := indexedPod("a", "default", nil, "owner-1", "owner-2")
+		Expect(indexer[NameField](obj)).To(Equal([]string{"a"}))
+		Expect(indexer[OwnerField](obj)).To(Equal([]string{"owner-1", "owner-2"}))
+		Expect(indexer[OwnerField](indexedPod("b", "default", nil))).To(BeEmpty())
+	})
+
+	It("indexes only the fields accepted in field selectors", func() {
+		indexer := fieldIndexer{}
+		Expect(RegisterInputIndexes(indexer, &corev1.Pod{})).To(Succeed())
+		for field := range indexer {
+			Expect(ValidateFieldSelector(field + "={{ .Name }}")).To(Succeed())
+		}
+	})
+})
+
+// a ctl.FieldIndexer which records the registered extractors by field
+type fieldIndexer map[string]ctl.IndexerFunc
+
+func (i fieldIndexer) IndexField(obj runtime.Object, field string, extractValue ctl.IndexerFunc) error {
+	i[field] = extractValue
+	return nil
+}
+
+func indexedPod(name, namespace string, labels map[string]string, owners ...types.UID) *corev1.Pod {
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels}}
+	for _, uid := range owners {
+		pod.OwnerReferences = append(pod.OwnerReferences, metav1.OwnerReference{UID: uid})
+	}
+	return pod
+}
@@ -0,0 +1,31 @@
+package scheduler
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// IgnoreStatusUpdates filters the update events of the top-level CRD down to changes to its spec
+// (i.e. its generation), its deletion, or the paused and force-phase annotations.
+// the status updates written by the scheduler itself are dropped, so they do not requeue the CRD
+// ahead of the backoff, timeout or resync interval of its phase
+func IgnoreStatusUpdates() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			if e.MetaOld == nil || e.MetaNew == nil {
+				return true
+			}
+			return e.MetaOld.GetGeneration() != e.MetaNew.GetGeneration() ||
+				!e.MetaOld.GetDeletionTimestamp().Equal(e.MetaNew.GetDeletionTimestamp()) ||
+				annotationChanged(e.MetaOld, e.MetaNew, PausedAnnotation) ||
+				annotationChanged(e.MetaOld, e.MetaNew, ForcePhaseAnnotation)
+		},
+	}
+}
+
+func annotationChanged(old, new metav1.Object, annotation string) bool {
+	oldValue, oldOk := old.GetAnnotations()[annotation]
+	newValue, newOk := new.GetAnnotations()[annotation]
+	return oldOk != newOk || oldValue != newValue
+}
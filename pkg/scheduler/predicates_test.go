@@ -0,0 +1,52 @@
+package scheduler_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	. "github.com/solo-io/autopilot/pkg/scheduler"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+var _ = DescribeTable("IgnoreStatusUpdates",
+	func(update func(obj *corev1.Pod), expected bool) {
+		old := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Name:        "canary",
+			Namespace:   "default",
+			Generation:  1,
+			Annotations: map[string]string{"note": "old"},
+		}}
+		new := old.DeepCopy()
+		update(new)
+		Expect(IgnoreStatusUpdates().Update(event.UpdateEvent{
+			MetaOld: old, ObjectOld: old,
+			MetaNew: new, ObjectNew: new,
+		})).To(Equal(expected))
+	},
+	Entry("drops status updates", func(obj *corev1.Pod) {
+		obj.ResourceVersion = "2"
+		obj.Status.Phase = corev1.PodRunning
+	}, false),
+	Entry("drops changes to other annotations", func(obj *corev1.Pod) {
+		obj.Annotations["note"] = "new"
+	}, false),
+	Entry("fires on spec changes", func(obj *corev1.Pod) {
+		obj.Generation = 2
+	}, true),
+	Entry("fires on deletion", func(obj *corev1.Pod) {
+		now := metav1.NewTime(time.Now())
+		obj.DeletionTimestamp = &now
+	}, true),
+	Entry("fires when paused", func(obj *corev1.Pod) {
+		obj.Annotations[PausedAnnotation] = "true"
+	}, true),
+	Entry("fires when resumed", func(obj *corev1.Pod) {
+		obj.Annotations = map[string]string{PausedAnnotation: "false"}
+	}, true),
+	Entry("fires when a phase is forced", func(obj *corev1.Pod) {
+		obj.Annotations[ForcePhaseAnnotation] = "Promoting"
+	}, true),
+)
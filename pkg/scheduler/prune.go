@@ -0,0 +1,47 @@
+package scheduler
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/solo-io/autopilot/pkg/ezkube"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// PruneOutputs deletes the objects in the existing list which are controlled by the owner
+// but absent from the desired list of outputs returned by a worker.
+// existing and desired must be lists of the same type
+func PruneOutputs(ctx context.Context, client ezkube.Client, owner metav1.Object, existing, desired ezkube.List) error {
+	desiredItems, err := apimeta.ExtractList(desired)
+	if err != nil {
+		return err
+	}
+	keep := map[types.NamespacedName]bool{}
+	for _, item := range desiredItems {
+		obj, err := apimeta.Accessor(item)
+		if err != nil {
+			return err
+		}
+		keep[types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}] = true
+	}
+
+	existingItems, err := apimeta.ExtractList(existing)
+	if err != nil {
+		return err
+	}
+	for _, item := range existingItems {
+		obj, ok := item.(ezkube.Object)
+		if !ok {
+			return errors.Errorf("cannot prune object of type %T", item)
+		}
+		if !metav1.IsControlledBy(obj, owner) || keep[types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}] {
+			continue
+		}
+		if err := deleteIgnoreNotFound(ctx, client, obj); err != nil {
+			return errors.Wrap(err, "pruning")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package scheduler_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"github.com/solo-io/autopilot/pkg/ezkube/fake"
+	. "github.com/solo-io/autopilot/pkg/scheduler"
+	corev1 "k8s.io/api/core/v1"
+	kubeerrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+)
+
+var _ = Describe("PruneOutputs", func() {
+	var (
+		ctx   = context.TODO()
+		owner = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "owner", Namespace: "default", UID: "owner-uid"}}
+		other = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default", UID: "other-uid"}}
+
+		client   *fake.Client
+		existing *corev1.ConfigMapList
+	)
+	BeforeEach(func() {
+		existing = &corev1.ConfigMapList{Items: []corev1.ConfigMap{
+			*ownedConfigMap("a", owner, true),
+			*ownedConfigMap("b", owner, true),
+			*ownedConfigMap("c", owner, false),
+			*ownedConfigMap("d", other, true),
+			*configMap("e"),
+		}}
+		var objs []runtime.Object
+		for i := range existing.Items {
+			objs = append(objs, existing.Items[i].DeepCopy())
+		}
+		var err error
+		client, err = fake.NewClient(scheme.Scheme, objs...)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	DescribeTable("deletes the controlled objects absent from the outputs",
+		func(outputs []string, remaining []string) {
+			Expect(PruneOutputs(ctx, client, owner, existing, configMaps(outputs...))).To(Succeed())
+			Expect(configMapNames(client)).To(Equal(remaining))
+		},
+		Entry("keeps objects still present in the outputs",
+			[]string{"a", "b"}, []string{"a", "b", "c", "d", "e"}),
+		Entry("deletes only the controlled objects which were removed from the outputs",
+			[]string{"a"}, []string{"a", "c", "d", "e"}),
+		Entry("keeps objects which are not controlled by the owner even if absent from the outputs",
+			[]string{"a", "b", "c", "d", "e"}, []string{"a", "b", "c", "d", "e"}),
+		Entry("deletes every controlled object when the outputs are empty",
+			nil, []string{"c", "d", "e"}),
+	)
+
+	It("matches outputs by namespace and name", func() {
+		outputs := configMaps("a", "b")
+		outputs.Items[1].Namespace = "other"
+		Expect(PruneOutputs(ctx, client, owner, existing, outputs)).To(Succeed())
+		Expect(configMapNames(client)).To(Equal([]string{"a", "c", "d", "e"}))
+	})
+
+	It("returns errors other than not found", func() {
+		err := PruneOutputs(ctx, &forbiddenDeletes{client}, owner, existing, configMaps())
+		Expect(err).To(HaveOccurred())
+		Expect(kubeerrs.IsForbidden(errors.Cause(err))).To(BeTrue())
+	})
+})
+
+// a config map with an owner reference to the owner, optionally as its controller
+func ownedConfigMap(name string, owner metav1.Object, controller bool) *corev1.ConfigMap {
+	cm := configMap(name)
+	ref := metav1.NewControllerRef(owner, corev1.SchemeGroupVersion.WithKind("ConfigMap"))
+	if !controller {
+		ref.Controller = nil
+	}
+	cm.OwnerReferences = []metav1.OwnerReference{*ref}
+	return cm
+}
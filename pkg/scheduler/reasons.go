@@ -0,0 +1,39 @@
+package scheduler
+
+// Reasons recorded by the generated scheduler in the phase history
+// and conditions of the top-level CRD
+const (
+	// the worker for the current phase returned the next phase
+	ReasonWorkerTransition = "WorkerTransition"
+
+	// the current phase timed out
+	ReasonPhaseTimeout = "PhaseTimeout"
+
+	// the worker for the current phase completed successfully
+	ReasonWorkerSucceeded = "WorkerSucceeded"
+
+	// the worker for the current phase returned an error
+	ReasonWorkerError = "WorkerError"
+
+	// the worker for the current phase panicked
+	ReasonWorkerPanic = "WorkerPanic"
+
+	// the worker for the current phase failed more than the maxRetries of the phase
+	ReasonRetriesExhausted = "RetriesExhausted"
+
+	// the worker for the current phase returned a transition
+	// which is not permitted by the phase graph
+	ReasonIllegalTransition = "IllegalTransition"
+
+	// the phase was set via the force-phase annotation
+	ReasonForcePhase = "ForcePhase"
+
+	// the paused annotation is set
+	ReasonPaused = "Paused"
+
+	// the paused annotation was removed
+	ReasonResumed = "Resumed"
+
+	// the spec of the CRD changed since it was last observed
+	ReasonSpecChanged = "SpecChanged"
+)
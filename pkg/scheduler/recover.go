@@ -0,0 +1,43 @@
+package scheduler
+
+import (
+	"fmt"
+	"runtime/debug"
+
+	"github.com/go-logr/logr"
+)
+
+// WorkerPanicError is returned by RunWorker when the worker panics
+type WorkerPanicError struct {
+	// the value passed to panic
+	Value interface{}
+
+	// the stack trace of the panicking goroutine
+	Stack []byte
+}
+
+func (e *WorkerPanicError) Error() string {
+	return fmt.Sprintf("worker panicked: %v", e.Value)
+}
+
+// RunWorker invokes a worker sync, recovering from any panic raised by the worker.
+// a recovered panic is logged with its stack trace and returned as a *WorkerPanicError,
+// so that a single bad CRD cannot crash the operator.
+func RunWorker(logger logr.Logger, sync func() error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			panicErr := &WorkerPanicError{Value: r, Stack: debug.Stack()}
+			logger.Error(panicErr, "Recovered from worker panic", "stack", string(panicErr.Stack))
+			err = panicErr
+		}
+	}()
+	return sync()
+}
+
+// WorkerErrorReason returns the reason to record for an error returned by RunWorker
+func WorkerErrorReason(err error) string {
+	if _, ok := err.(*WorkerPanicError); ok {
+		return ReasonWorkerPanic
+	}
+	return ReasonWorkerError
+}
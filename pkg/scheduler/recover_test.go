@@ -0,0 +1,42 @@
+package scheduler_test
+
+import (
+	"errors"
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	. "github.com/solo-io/autopilot/pkg/scheduler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+var _ = Describe("RunWorker", func() {
+	logger := log.NullLogger{}
+
+	It("returns the result of a worker which does not panic", func() {
+		Expect(RunWorker(logger, func() error { return nil })).To(Succeed())
+
+		workerErr := errors.New("failed")
+		err := RunWorker(logger, func() error { return workerErr })
+		Expect(err).To(Equal(workerErr))
+		Expect(WorkerErrorReason(err)).To(Equal(ReasonWorkerError))
+	})
+
+	DescribeTable("converts panics to errors",
+		func(worker func() error, message string) {
+			err := RunWorker(logger, worker)
+			Expect(err).To(BeAssignableToTypeOf(&WorkerPanicError{}))
+			Expect(err.Error()).To(Equal(message))
+			Expect(string(err.(*WorkerPanicError).Stack)).To(ContainSubstring("recover_test.go"))
+			Expect(WorkerErrorReason(err)).To(Equal(ReasonWorkerPanic))
+		},
+		Entry("a string", func() error { panic("implement me!") }, "worker panicked: implement me!"),
+		Entry("an error", func() error { panic(fmt.Errorf("boom")) }, "worker panicked: boom"),
+		Entry("a runtime error", func() error {
+			var phases map[string]string
+			phases["Initializing"] = "Waiting"
+			return nil
+		}, "worker panicked: assignment to entry in nil map"),
+	)
+})
@@ -0,0 +1,124 @@
+package scheduler
+
+import (
+	"bytes"
+	"regexp"
+	"text/template"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	ctl "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Selector restricts the objects listed for a phase input.
+// the label and field selectors are text/templates which are executed against the top-level CRD,
+// e.g. `app={{ .Name }}`
+type Selector struct {
+	labelSelector *template.Template
+	fieldSelector *template.Template
+}
+
+// ParseSelector parses the label and field selector templates of a phase input.
+// empty templates select everything
+func ParseSelector(labelSelector, fieldSelector string) (*Selector, error) {
+	s := &Selector{}
+	if labelSelector != "" {
+		tmpl, err := template.New("labelSelector").Option("missingkey=error").Parse(labelSelector)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parsing label selector %q", labelSelector)
+		}
+		s.labelSelector = tmpl
+	}
+	if fieldSelector != "" {
+		tmpl, err := template.New("fieldSelector").Option("missingkey=error").Parse(fieldSelector)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parsing field selector %q", fieldSelector)
+		}
+		s.fieldSelector = tmpl
+	}
+	return s, nil
+}
+
+// MustParseSelector parses the selector templates or panics. used by the generated scheduler
+func MustParseSelector(labelSelector, fieldSelector string) *Selector {
+	s, err := ParseSelector(labelSelector, fieldSelector)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// ListOptions renders the selector templates with the given top-level CRD
+func (s *Selector) ListOptions(crd interface{}) ([]ctl.ListOption, error) {
+	var opts []ctl.ListOption
+	if s.labelSelector != nil {
+		rendered, err := render(s.labelSelector, crd)
+		if err != nil {
+			return nil, err
+		}
+		selector, err := labels.Parse(rendered)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid label selector %q", rendered)
+		}
+		opts = append(opts, ctl.MatchingLabelsSelector{Selector: selector})
+	}
+	if s.fieldSelector != nil {
+		rendered, err := render(s.fieldSelector, crd)
+		if err != nil {
+			return nil, err
+		}
+		selector, err := fields.ParseSelector(rendered)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid field selector %q", rendered)
+		}
+		if err := checkFieldSelector(selector); err != nil {
+			return nil, errors.Wrapf(err, "unsupported field selector %q", rendered)
+		}
+		opts = append(opts, ctl.MatchingFieldsSelector{Selector: selector})
+	}
+	return opts, nil
+}
+
+// matches the actions of a selector template
+var templateAction = regexp.MustCompile(`(?s){{.*?}}`)
+
+// ValidateFieldSelector checks that a field selector template can be served by the manager's cache,
+// which only supports a single exact match on an indexed field, e.g. `metadata.name={{ .Spec.Target }}`.
+// the template actions are replaced by a placeholder value, as the top-level CRD is not known until runtime
+func ValidateFieldSelector(fieldSelector string) error {
+	if fieldSelector == "" {
+		return nil
+	}
+	selector, err := fields.ParseSelector(templateAction.ReplaceAllString(fieldSelector, "value"))
+	if err != nil {
+		return errors.Wrapf(err, "invalid field selector %q", fieldSelector)
+	}
+	if err := checkFieldSelector(selector); err != nil {
+		return errors.Wrapf(err, "unsupported field selector %q", fieldSelector)
+	}
+	return nil
+}
+
+func checkFieldSelector(selector fields.Selector) error {
+	requirements := selector.Requirements()
+	if len(requirements) != 1 {
+		return errors.Errorf("expected a single requirement, found %v", len(requirements))
+	}
+	switch req := requirements[0]; {
+	case req.Operator != selection.Equals && req.Operator != selection.DoubleEquals:
+		return errors.Errorf("only exact matches (=) are supported")
+	case req.Field != NameField && req.Field != OwnerField:
+		return errors.Errorf("inputs are only indexed by %v and %v", NameField, OwnerField)
+	}
+	return nil
+}
+
+func render(tmpl *template.Template, data interface{}) (string, error) {
+	buf := &bytes.Buffer{}
+	if err := tmpl.Execute(buf, data); err != nil {
+		return "", errors.Wrapf(err, "rendering %v", tmpl.Name())
+	}
+	return buf.String(), nil
+}
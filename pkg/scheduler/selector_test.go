@@ -0,0 +1,31 @@
+package scheduler_test
+
+import (
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	. "github.com/solo-io/autopilot/pkg/scheduler"
+)
+
+var _ = DescribeTable("ValidateFieldSelector",
+	func(fieldSelector string, errMatcher OmegaMatcher) {
+		err := ValidateFieldSelector(fieldSelector)
+		if errMatcher == nil {
+			Expect(err).NotTo(HaveOccurred())
+			return
+		}
+		Expect(err).To(MatchError(errMatcher))
+	},
+	Entry("no selector", "", nil),
+	Entry("an exact name", "metadata.name=canary", nil),
+	Entry("a templated name", "metadata.name={{ .Spec.Target }}", nil),
+	Entry("a templated owner", "metadata.ownerReferences.uid={{ .UID }}", nil),
+	Entry("a double equals match", "metadata.name=={{ .Name }}", nil),
+	Entry("a not equals match", "metadata.name!={{ .Name }}",
+		ContainSubstring("only exact matches (=) are supported")),
+	Entry("multiple requirements", "metadata.name={{ .Name }},metadata.ownerReferences.uid={{ .UID }}",
+		ContainSubstring("expected a single requirement, found 2")),
+	Entry("a field which is not indexed", "status.phase=Running",
+		ContainSubstring("inputs are only indexed by")),
+	Entry("a malformed selector", "metadata.name",
+		ContainSubstring("invalid field selector")),
+)
@@ -0,0 +1,56 @@
+package scheduler
+
+import (
+	"fmt"
+	"runtime/debug"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// EnqueueRequestsForLabel enqueues the CRD named by the value of the given label on the changed object.
+// the CRD is expected to live in the namespace of the changed object.
+func EnqueueRequestsForLabel(label string) handler.EventHandler {
+	return &handler.EnqueueRequestsFromMapFunc{
+		ToRequests: handler.ToRequestsFunc(func(obj handler.MapObject) []reconcile.Request {
+			name, ok := obj.Meta.GetLabels()[label]
+			if !ok || name == "" {
+				return nil
+			}
+			return []reconcile.Request{{NamespacedName: types.NamespacedName{
+				Namespace: obj.Meta.GetNamespace(),
+				Name:      name,
+			}}}
+		}),
+	}
+}
+
+// Mapper maps a changed object to the CRDs which should be reconciled
+type Mapper func(obj runtime.Object) ([]types.NamespacedName, error)
+
+// EnqueueRequestsFromMapper enqueues the CRDs returned by the user-supplied mapper.
+// errors returned by the mapper and panics raised by it are logged and no CRDs are enqueued.
+func EnqueueRequestsFromMapper(logger logr.Logger, mapper Mapper) handler.EventHandler {
+	return &handler.EnqueueRequestsFromMapFunc{
+		ToRequests: handler.ToRequestsFunc(func(obj handler.MapObject) (requests []reconcile.Request) {
+			defer func() {
+				if r := recover(); r != nil {
+					logger.Error(fmt.Errorf("mapper panicked: %v", r), "Recovered from mapper panic", "stack", string(debug.Stack()))
+					requests = nil
+				}
+			}()
+			names, err := mapper(obj.Object)
+			if err != nil {
+				logger.Error(err, "Failed to map changed object", "namespace", obj.Meta.GetNamespace(), "name", obj.Meta.GetName())
+				return nil
+			}
+			for _, name := range names {
+				requests = append(requests, reconcile.Request{NamespacedName: name})
+			}
+			return requests
+		}),
+	}
+}
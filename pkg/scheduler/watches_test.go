@@ -0,0 +1,113 @@
+package scheduler_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	. "github.com/solo-io/autopilot/pkg/scheduler"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = DescribeTable("input watch handlers",
+	func(h handler.EventHandler, obj runtime.Object, expected []reconcile.Request) {
+		Expect(enqueued(h, obj)).To(Equal(expected))
+	},
+	Entry("enqueue the controller of an owned input",
+		ownerHandler(), pod(nil, controlledBy("ConfigMap", "canary")),
+		requests("default", "canary")),
+	Entry("ignore owner references which are not controllers",
+		ownerHandler(), pod(nil, metav1.OwnerReference{APIVersion: "v1", Kind: "ConfigMap", Name: "canary"}),
+		nil),
+	Entry("ignore controllers of another kind",
+		ownerHandler(), pod(nil, controlledBy("Secret", "canary")),
+		nil),
+	Entry("enqueue the CRD named by the label",
+		EnqueueRequestsForLabel("app"), pod(map[string]string{"app": "canary"}),
+		requests("default", "canary")),
+	Entry("ignore inputs without the label",
+		EnqueueRequestsForLabel("app"), pod(map[string]string{"version": "v1"}),
+		nil),
+	Entry("ignore inputs with an empty label",
+		EnqueueRequestsForLabel("app"), pod(map[string]string{"app": ""}),
+		nil),
+	Entry("enqueue the CRDs returned by the mapper",
+		EnqueueRequestsFromMapper(log.NullLogger{}, func(obj runtime.Object) ([]types.NamespacedName, error) {
+			return []types.NamespacedName{{Namespace: "default", Name: "a"}, {Namespace: "other", Name: "b"}}, nil
+		}), pod(nil),
+		append(requests("default", "a"), requests("other", "b")...)),
+	Entry("pass the changed input to the mapper",
+		EnqueueRequestsFromMapper(log.NullLogger{}, func(obj runtime.Object) ([]types.NamespacedName, error) {
+			return []types.NamespacedName{{Namespace: "default", Name: obj.(*corev1.Pod).Labels["app"]}}, nil
+		}), pod(map[string]string{"app": "canary"}),
+		requests("default", "canary")),
+	Entry("enqueue nothing when the mapper errors",
+		EnqueueRequestsFromMapper(log.NullLogger{}, func(obj runtime.Object) ([]types.NamespacedName, error) {
+			return []types.NamespacedName{{Namespace: "default", Name: "a"}}, errors.New("failed")
+		}), pod(nil),
+		nil),
+	Entry("enqueue nothing when the mapper panics",
+		EnqueueRequestsFromMapper(log.NullLogger{}, func(obj runtime.Object) ([]types.NamespacedName, error) {
+			panic("implement me!")
+		}), pod(nil),
+		nil),
+)
+
+// the handler registered by the generated scheduler for inputs watched by owner reference
+func ownerHandler() handler.EventHandler {
+	h := &handler.EnqueueRequestForOwner{IsController: true, OwnerType: &corev1.ConfigMap{}}
+	mapper := apimeta.NewDefaultRESTMapper(nil)
+	mapper.Add(corev1.SchemeGroupVersion.WithKind("ConfigMap"), apimeta.RESTScopeNamespace)
+	if err := h.InjectScheme(scheme.Scheme); err != nil {
+		panic(err)
+	}
+	if err := h.InjectMapper(mapper); err != nil {
+		panic(err)
+	}
+	return h
+}
+
+func controlledBy(kind, name string) metav1.OwnerReference {
+	return metav1.OwnerReference{APIVersion: "v1", Kind: kind, Name: name, Controller: pointer.BoolPtr(true)}
+}
+
+func pod(labels map[string]string, owners ...metav1.OwnerReference) *corev1.Pod {
+	return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:            "input",
+		Namespace:       "default",
+		Labels:          labels,
+		OwnerReferences: owners,
+	}}
+}
+
+func requests(namespace, name string) []reconcile.Request {
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: namespace, Name: name}}}
+}
+
+// the requests enqueued by the handler for the creation of the object
+func enqueued(h handler.EventHandler, obj runtime.Object) []reconcile.Request {
+	meta, err := apimeta.Accessor(obj)
+	Expect(err).NotTo(HaveOccurred())
+	queue := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+	defer queue.ShutDown()
+	h.Create(event.CreateEvent{Meta: meta, Object: obj}, queue)
+
+	var enqueued []reconcile.Request
+	for queue.Len() > 0 {
+		item, _ := queue.Get()
+		enqueued = append(enqueued, item.(reconcile.Request))
+		queue.Done(item)
+	}
+	return enqueued
+}
@@ -21,3 +21,62 @@ const (
 	// Rolling back the canary
 	CanaryDeploymentPhaseRollBack CanaryDeploymentPhase = "RollBack"
 )
+
+// the phases to which each CanaryDeploymentPhase may transition.
+// phases which do not declare transitions may transition to any phase.
+var canaryDeploymentPhaseTransitions = map[CanaryDeploymentPhase][]CanaryDeploymentPhase{
+	CanaryDeploymentPhaseInitializing: {
+		CanaryDeploymentPhaseWaiting,
+	},
+	CanaryDeploymentPhaseWaiting: {
+		CanaryDeploymentPhaseEvaluating,
+	},
+	CanaryDeploymentPhaseEvaluating: {
+		CanaryDeploymentPhasePromoting,
+		CanaryDeploymentPhaseRollBack,
+	},
+	CanaryDeploymentPhasePromoting: {
+		CanaryDeploymentPhaseWaiting,
+	},
+	CanaryDeploymentPhaseRollBack: {
+		CanaryDeploymentPhaseWaiting,
+	},
+}
+
+// IsValid returns true if the phase is a known CanaryDeploymentPhase
+func (p CanaryDeploymentPhase) IsValid() bool {
+	switch p {
+	case CanaryDeploymentPhaseInitializing:
+		return true
+	case CanaryDeploymentPhaseWaiting:
+		return true
+	case CanaryDeploymentPhaseEvaluating:
+		return true
+	case CanaryDeploymentPhasePromoting:
+		return true
+	case CanaryDeploymentPhaseRollBack:
+		return true
+	}
+	return false
+}
+
+// CanTransitionTo returns true if the phase is permitted to transition to the next phase.
+// Remaining in the current phase is always permitted.
+func (p CanaryDeploymentPhase) CanTransitionTo(next CanaryDeploymentPhase) bool {
+	if !next.IsValid() {
+		return false
+	}
+	if p == next {
+		return true
+	}
+	allowed, ok := canaryDeploymentPhaseTransitions[p]
+	if !ok {
+		return true
+	}
+	for _, phase := range allowed {
+		if phase == next {
+			return true
+		}
+	}
+	return false
+}
@@ -37,9 +37,6 @@ type CanaryDeploymentSpec struct {
 // CanaryDeploymentStatusInfo defines an observed condition of CanaryDeployment
 // +k8s:openapi-gen=true
 type CanaryDeploymentStatusInfo struct {
-	// used to record the time processing started
-	TimeStarted metav1.Time `json:"timeStarted,omitempty"`
-
 	// record the history of the canary (promotions/rollbacks)
 	History []CanaryResult `json:"history,omitempty"`
 }
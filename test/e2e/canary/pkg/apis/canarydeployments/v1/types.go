@@ -3,6 +3,7 @@
 package v1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -29,12 +30,69 @@ type CanaryDeploymentStatus struct {
 	// ObservedGeneration was the last metadata.generation of the CanaryDeployment
 	// observed by the operator. If this does not match the metadata.generation of the CanaryDeployment,
 	// it means the operator has not yet reconciled the current generation of the operator
-	ObservedGeneration int64 `json:"observedGeneration",omitempty`
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// PhaseEntryTime is the time at which the CanaryDeployment entered its current Phase.
+	// It is used by the scheduler to enforce phase timeouts.
+	PhaseEntryTime metav1.Time `json:"phaseEntryTime,omitempty"`
+
+	// Conditions represent the latest available observations of the CanaryDeployment's state.
+	Conditions []CanaryDeploymentCondition `json:"conditions,omitempty"`
+
+	// PhaseHistory records the most recent phase transitions of the CanaryDeployment, oldest first.
+	// At most 10 transitions are retained.
+	PhaseHistory []CanaryDeploymentPhaseTransition `json:"phaseHistory,omitempty"`
+
+	// FailureCount is the number of consecutive times the worker for the current Phase has failed.
+	// It is reset when the worker succeeds or the CanaryDeployment transitions to another Phase.
+	FailureCount int32 `json:"failureCount,omitempty"`
+
+	// LastError is the error returned by the most recent failed worker sync.
+	// It is cleared when the worker succeeds.
+	LastError string `json:"lastError,omitempty"`
 
 	// StatusInfo defines the observed state of the CanaryDeployment in the cluster
 	CanaryDeploymentStatusInfo
 }
 
+// CanaryDeploymentCondition describes the state of a CanaryDeployment at a certain point.
+// +k8s:openapi-gen=true
+type CanaryDeploymentCondition struct {
+	// Type of the condition, e.g. Synced.
+	Type CanaryDeploymentConditionType `json:"type"`
+
+	// Status of the condition, one of True, False, Unknown.
+	Status corev1.ConditionStatus `json:"status"`
+
+	// ObservedGeneration is the metadata.generation of the CanaryDeployment when the condition was set.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// LastTransitionTime is the last time the condition transitioned from one status to another.
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+
+	// Reason is a brief CamelCase reason for the condition's last transition.
+	Reason string `json:"reason,omitempty"`
+
+	// Message is a human readable message indicating details about the transition.
+	Message string `json:"message,omitempty"`
+}
+
+// CanaryDeploymentPhaseTransition records a single transition between phases of a CanaryDeployment.
+// +k8s:openapi-gen=true
+type CanaryDeploymentPhaseTransition struct {
+	// From is the phase the CanaryDeployment transitioned from.
+	From CanaryDeploymentPhase `json:"from"`
+
+	// To is the phase the CanaryDeployment transitioned to.
+	To CanaryDeploymentPhase `json:"to"`
+
+	// Time at which the transition occurred.
+	Time metav1.Time `json:"time"`
+
+	// Reason is a brief CamelCase reason for the transition.
+	Reason string `json:"reason,omitempty"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // CanaryDeploymentList contains a list of CanaryDeployment
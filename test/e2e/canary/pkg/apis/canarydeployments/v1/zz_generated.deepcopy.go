@@ -1,3 +1,4 @@
+//go:build !ignore_autogenerated
 // +build !ignore_autogenerated
 
 /*
@@ -51,6 +52,23 @@ func (in *CanaryDeployment) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryDeploymentCondition) DeepCopyInto(out *CanaryDeploymentCondition) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryDeploymentCondition.
+func (in *CanaryDeploymentCondition) DeepCopy() *CanaryDeploymentCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(CanaryDeploymentCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CanaryDeploymentList) DeepCopyInto(out *CanaryDeploymentList) {
 	*out = *in
@@ -84,6 +102,23 @@ func (in *CanaryDeploymentList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryDeploymentPhaseTransition) DeepCopyInto(out *CanaryDeploymentPhaseTransition) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryDeploymentPhaseTransition.
+func (in *CanaryDeploymentPhaseTransition) DeepCopy() *CanaryDeploymentPhaseTransition {
+	if in == nil {
+		return nil
+	}
+	out := new(CanaryDeploymentPhaseTransition)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CanaryDeploymentSpec) DeepCopyInto(out *CanaryDeploymentSpec) {
 	*out = *in
@@ -111,6 +146,21 @@ func (in *CanaryDeploymentSpec) DeepCopy() *CanaryDeploymentSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CanaryDeploymentStatus) DeepCopyInto(out *CanaryDeploymentStatus) {
 	*out = *in
+	in.PhaseEntryTime.DeepCopyInto(&out.PhaseEntryTime)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]CanaryDeploymentCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PhaseHistory != nil {
+		in, out := &in.PhaseHistory, &out.PhaseHistory
+		*out = make([]CanaryDeploymentPhaseTransition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.CanaryDeploymentStatusInfo.DeepCopyInto(&out.CanaryDeploymentStatusInfo)
 	return
 }
@@ -128,7 +178,6 @@ func (in *CanaryDeploymentStatus) DeepCopy() *CanaryDeploymentStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CanaryDeploymentStatusInfo) DeepCopyInto(out *CanaryDeploymentStatusInfo) {
 	*out = *in
-	in.TimeStarted.DeepCopyInto(&out.TimeStarted)
 	if in.History != nil {
 		in, out := &in.History, &out.History
 		*out = make([]CanaryResult, len(*in))
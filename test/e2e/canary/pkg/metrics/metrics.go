@@ -4,6 +4,7 @@ package metrics
 
 import (
 	"context"
+	"time"
 
 	"github.com/solo-io/autopilot/pkg/metrics"
 )
@@ -11,9 +12,17 @@ import (
 type CanaryDeploymentMetrics interface {
 	metrics.Client
 	GetIstioSuccessRate(ctx context.Context, Namespace, Name, Interval string) (*metrics.QueryResult, error)
+	GetIstioSuccessRateValue(ctx context.Context, Namespace, Name, Interval string) (float64, error)
+	GetIstioSuccessRateRange(ctx context.Context, Namespace, Name, Interval string, start, end time.Time, step time.Duration) (*metrics.QueryResult, error)
 	GetIstioRequestDuration(ctx context.Context, Namespace, Name, Interval string) (*metrics.QueryResult, error)
+	GetIstioRequestDurationValue(ctx context.Context, Namespace, Name, Interval string) (float64, error)
+	GetIstioRequestDurationRange(ctx context.Context, Namespace, Name, Interval string, start, end time.Time, step time.Duration) (*metrics.QueryResult, error)
 	GetEnvoySuccessRate(ctx context.Context, Namespace, Name, Interval string) (*metrics.QueryResult, error)
+	GetEnvoySuccessRateValue(ctx context.Context, Namespace, Name, Interval string) (float64, error)
+	GetEnvoySuccessRateRange(ctx context.Context, Namespace, Name, Interval string, start, end time.Time, step time.Duration) (*metrics.QueryResult, error)
 	GetEnvoyRequestDuration(ctx context.Context, Namespace, Name, Interval string) (*metrics.QueryResult, error)
+	GetEnvoyRequestDurationValue(ctx context.Context, Namespace, Name, Interval string) (float64, error)
+	GetEnvoyRequestDurationRange(ctx context.Context, Namespace, Name, Interval string, start, end time.Time, step time.Duration) (*metrics.QueryResult, error)
 }
 
 type metricsClient struct {
@@ -52,6 +61,43 @@ func (c *metricsClient) GetIstioSuccessRate(ctx context.Context, Namespace, Name
 	return c.Client.RunQuery(ctx, queryTemplate, queryParameters)
 }
 
+// GetIstioSuccessRateValue returns the single value of the query, or metrics.ErrNoData if the query returned no data
+func (c *metricsClient) GetIstioSuccessRateValue(ctx context.Context, Namespace, Name, Interval string) (float64, error) {
+	result, err := c.GetIstioSuccessRate(ctx, Namespace, Name, Interval)
+	if err != nil {
+		return 0, err
+	}
+	return result.Scalar()
+}
+
+func (c *metricsClient) GetIstioSuccessRateRange(ctx context.Context, Namespace, Name, Interval string, start, end time.Time, step time.Duration) (*metrics.QueryResult, error) {
+	queryTemplate := `sum(
+		rate(
+			istio_requests_total{
+				destination_workload_namespace="{{ .Namespace }}",
+				destination_workload=~"{{ .Name }}",
+				response_code!~"5.*"
+			}[{{ .Interval }}]
+		)
+	) 
+	/ 
+	sum(
+		rate(
+			istio_requests_total{
+				destination_workload_namespace="{{ .Namespace }}",
+				destination_workload=~"{{ .Name }}"
+			}[{{ .Interval }}]
+		)
+	) 
+	* 100`
+	queryParameters := map[string]string{
+		"Namespace": Namespace,
+		"Name":      Name,
+		"Interval":  Interval,
+	}
+	return c.Client.RunRangeQuery(ctx, queryTemplate, queryParameters, start, end, step)
+}
+
 func (c *metricsClient) GetIstioRequestDuration(ctx context.Context, Namespace, Name, Interval string) (*metrics.QueryResult, error) {
 	queryTemplate := `histogram_quantile(
 		0.99,
@@ -72,6 +118,35 @@ func (c *metricsClient) GetIstioRequestDuration(ctx context.Context, Namespace,
 	return c.Client.RunQuery(ctx, queryTemplate, queryParameters)
 }
 
+// GetIstioRequestDurationValue returns the single value of the query, or metrics.ErrNoData if the query returned no data
+func (c *metricsClient) GetIstioRequestDurationValue(ctx context.Context, Namespace, Name, Interval string) (float64, error) {
+	result, err := c.GetIstioRequestDuration(ctx, Namespace, Name, Interval)
+	if err != nil {
+		return 0, err
+	}
+	return result.Scalar()
+}
+
+func (c *metricsClient) GetIstioRequestDurationRange(ctx context.Context, Namespace, Name, Interval string, start, end time.Time, step time.Duration) (*metrics.QueryResult, error) {
+	queryTemplate := `histogram_quantile(
+		0.99,
+		sum(
+			rate(
+				istio_request_duration_seconds_bucket{
+					destination_workload_namespace="{{ .Namespace }}",
+					destination_workload=~"{{ .Name }}"
+				}[{{ .Interval }}]
+			)
+		) by (le)
+	)`
+	queryParameters := map[string]string{
+		"Namespace": Namespace,
+		"Name":      Name,
+		"Interval":  Interval,
+	}
+	return c.Client.RunRangeQuery(ctx, queryTemplate, queryParameters, start, end, step)
+}
+
 func (c *metricsClient) GetEnvoySuccessRate(ctx context.Context, Namespace, Name, Interval string) (*metrics.QueryResult, error) {
 	queryTemplate := `sum(
 		rate(
@@ -100,6 +175,43 @@ func (c *metricsClient) GetEnvoySuccessRate(ctx context.Context, Namespace, Name
 	return c.Client.RunQuery(ctx, queryTemplate, queryParameters)
 }
 
+// GetEnvoySuccessRateValue returns the single value of the query, or metrics.ErrNoData if the query returned no data
+func (c *metricsClient) GetEnvoySuccessRateValue(ctx context.Context, Namespace, Name, Interval string) (float64, error) {
+	result, err := c.GetEnvoySuccessRate(ctx, Namespace, Name, Interval)
+	if err != nil {
+		return 0, err
+	}
+	return result.Scalar()
+}
+
+func (c *metricsClient) GetEnvoySuccessRateRange(ctx context.Context, Namespace, Name, Interval string, start, end time.Time, step time.Duration) (*metrics.QueryResult, error) {
+	queryTemplate := `sum(
+		rate(
+			envoy_cluster_upstream_rq{
+				kubernetes_namespace="{{ .Namespace }}",
+				kubernetes_pod_name=~"{{ .Name }}-[0-9a-zA-Z]+(-[0-9a-zA-Z]+)",
+				envoy_response_code!~"5.*"
+			}[{{ .Interval }}]
+		)
+	) 
+	/ 
+	sum(
+		rate(
+			envoy_cluster_upstream_rq{
+				kubernetes_namespace="{{ .Namespace }}",
+				kubernetes_pod_name=~"{{ .Name }}-[0-9a-zA-Z]+(-[0-9a-zA-Z]+)"
+			}[{{ .Interval }}]
+		)
+	) 
+	* 100`
+	queryParameters := map[string]string{
+		"Namespace": Namespace,
+		"Name":      Name,
+		"Interval":  Interval,
+	}
+	return c.Client.RunRangeQuery(ctx, queryTemplate, queryParameters, start, end, step)
+}
+
 func (c *metricsClient) GetEnvoyRequestDuration(ctx context.Context, Namespace, Name, Interval string) (*metrics.QueryResult, error) {
 	queryTemplate := `histogram_quantile(
 		0.99,
@@ -119,3 +231,32 @@ func (c *metricsClient) GetEnvoyRequestDuration(ctx context.Context, Namespace,
 	}
 	return c.Client.RunQuery(ctx, queryTemplate, queryParameters)
 }
+
+// GetEnvoyRequestDurationValue returns the single value of the query, or metrics.ErrNoData if the query returned no data
+func (c *metricsClient) GetEnvoyRequestDurationValue(ctx context.Context, Namespace, Name, Interval string) (float64, error) {
+	result, err := c.GetEnvoyRequestDuration(ctx, Namespace, Name, Interval)
+	if err != nil {
+		return 0, err
+	}
+	return result.Scalar()
+}
+
+func (c *metricsClient) GetEnvoyRequestDurationRange(ctx context.Context, Namespace, Name, Interval string, start, end time.Time, step time.Duration) (*metrics.QueryResult, error) {
+	queryTemplate := `histogram_quantile(
+		0.99,
+		sum(
+			rate(
+				envoy_cluster_upstream_rq_time_bucket{
+					kubernetes_namespace="{{ .Namespace }}",
+					kubernetes_pod_name=~"{{ .Name }}-[0-9a-zA-Z]+(-[0-9a-zA-Z]+)"
+				}[{{ .Interval }}]
+			)
+		) by (le)
+	)`
+	queryParameters := map[string]string{
+		"Namespace": Namespace,
+		"Name":      Name,
+		"Interval":  Interval,
+	}
+	return c.Client.RunRangeQuery(ctx, queryTemplate, queryParameters, start, end, step)
+}
@@ -12,6 +12,8 @@ import (
 	"github.com/golang/protobuf/ptypes"
 
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 
 	ctl "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -27,6 +29,7 @@ import (
 
 	v1 "github.com/solo-io/autopilot/test/e2e/canary/pkg/apis/canarydeployments/v1"
 	canarydeploymentmetrics "github.com/solo-io/autopilot/test/e2e/canary/pkg/metrics"
+
 	evaluating "github.com/solo-io/autopilot/test/e2e/canary/pkg/workers/evaluating"
 	initializing "github.com/solo-io/autopilot/test/e2e/canary/pkg/workers/initializing"
 	promoting "github.com/solo-io/autopilot/test/e2e/canary/pkg/workers/promoting"
@@ -38,19 +41,20 @@ import (
 )
 
 func AddToManager(params scheduler.Params) error {
-	scheduler, err := NewScheduler(params)
+	s, err := NewScheduler(params)
 	if err != nil {
 		return err
 	}
 	// Create a new controller
-	c, err := controller.New("canaryDeployment-controller", params.Manager, controller.Options{Reconciler: scheduler})
+	c, err := controller.New("canaryDeployment-controller", params.Manager, controller.Options{Reconciler: s})
 	if err != nil {
 		return err
 	}
 
 	// Watch for changes to primary resource CanaryDeployment
 	params.Logger.Info("Registering watch for primary resource CanaryDeployment")
-	err = c.Watch(&source.Kind{Type: &v1.CanaryDeployment{}}, &handler.EnqueueRequestForObject{})
+	// status updates are ignored, so the scheduler's own status writes do not requeue the CanaryDeployment
+	err = c.Watch(&source.Kind{Type: &v1.CanaryDeployment{}}, &handler.EnqueueRequestForObject{}, scheduler.IgnoreStatusUpdates())
 	if err != nil {
 		return err
 	}
@@ -85,17 +89,29 @@ func AddToManager(params scheduler.Params) error {
 		return err
 	}
 
+	// Index input resource Deployments for field selectors
+	if err := scheduler.RegisterInputIndexes(params.Manager.GetFieldIndexer(), &appsv1.Deployment{}); err != nil {
+		return err
+	}
+
+	// Index input resource VirtualServices for field selectors
+	if err := scheduler.RegisterInputIndexes(params.Manager.GetFieldIndexer(), &istiov1alpha3.VirtualService{}); err != nil {
+		return err
+	}
+
 	return nil
 
 }
 
 type Scheduler struct {
-	ctx          context.Context
-	mgr          manager.Manager
-	namespace    string
-	logger       logr.Logger
-	metrics      canarydeploymentmetrics.CanaryDeploymentMetrics
-	workInterval time.Duration
+	ctx            context.Context
+	mgr            manager.Manager
+	namespace      string
+	logger         logr.Logger
+	metrics        canarydeploymentmetrics.CanaryDeploymentMetrics
+	workInterval   time.Duration
+	controlPlaneNs string
+	recorder       record.EventRecorder
 }
 
 func NewScheduler(params scheduler.Params) (*Scheduler, error) {
@@ -105,20 +121,22 @@ func NewScheduler(params scheduler.Params) (*Scheduler, error) {
 	if err != nil {
 		return nil, err
 	}
-	metricsServer := metrics.GetMetricsServerAddr(cfg.MeshProvider, cfg.ControlPlaneNs)
-	metricsBase, err := metrics.NewPrometheusClient(metricsServer)
+	// the manager's cache is not yet started, so discovery reads from the API server
+	metricsBase, err := metrics.NewMetricsClient(params.Ctx, params.Manager.GetAPIReader(), cfg)
 	if err != nil {
 		return nil, err
 	}
 	metricsClient := canarydeploymentmetrics.NewMetricsClient(metricsBase)
 
 	return &Scheduler{
-		ctx:          params.Ctx,
-		mgr:          params.Manager,
-		namespace:    params.Namespace,
-		logger:       params.Logger,
-		workInterval: workInterval,
-		metrics:      metricsClient,
+		ctx:            params.Ctx,
+		mgr:            params.Manager,
+		namespace:      params.Namespace,
+		logger:         params.Logger,
+		workInterval:   workInterval,
+		controlPlaneNs: config.ControlPlaneNamespace(cfg),
+		recorder:       params.Manager.GetEventRecorderFor("canary-operator"),
+		metrics:        metricsClient,
 	}, nil
 }
 
@@ -140,7 +158,41 @@ func (s *Scheduler) Reconcile(request reconcile.Request) (reconcile.Result, erro
 	}
 
 	// store original status for comparison after sync
-	status := canaryDeployment.Status
+	status := *canaryDeployment.Status.DeepCopy()
+
+	// a CanaryDeployment without a phase is in the initial phase
+	if canaryDeployment.Status.Phase == "" {
+		canaryDeployment.Status.Phase = v1.CanaryDeploymentPhaseInitializing
+	}
+
+	// record the time at which the CanaryDeployment entered its current phase
+	if canaryDeployment.Status.PhaseEntryTime.IsZero() {
+		canaryDeployment.Status.PhaseEntryTime = metav1.Now()
+	}
+
+	// honor a phase override requested via the force-phase annotation
+	if forcedPhase, ok := scheduler.ForcedPhase(canaryDeployment); ok {
+		return result, s.forcePhase(client, canaryDeployment, v1.CanaryDeploymentPhase(forcedPhase))
+	}
+
+	// do not run workers while the CanaryDeployment is paused.
+	// spec changes made while paused are handled once the CanaryDeployment is resumed
+	if scheduler.IsPaused(canaryDeployment) {
+		s.logger.Info("CanaryDeployment is paused, skipping sync", "canaryDeployment", canaryDeployment.Namespace+"."+canaryDeployment.Name, "phase", canaryDeployment.Status.Phase)
+		canaryDeployment.Status.MarkConditionTrue(v1.CanaryDeploymentConditionPaused, canaryDeployment.Generation, scheduler.ReasonPaused, "the "+scheduler.PausedAnnotation+" annotation is set")
+		return result, s.updateStatus(client, canaryDeployment, status)
+	}
+	if canaryDeployment.Status.IsConditionTrue(v1.CanaryDeploymentConditionPaused) {
+		canaryDeployment.Status.MarkConditionFalse(v1.CanaryDeploymentConditionPaused, canaryDeployment.Generation, scheduler.ReasonResumed, "")
+	}
+
+	// move the CanaryDeployment to phase Initializing when its spec has changed.
+	// a CanaryDeployment already in phase Initializing stays there, and only the new generation is observed
+	if canaryDeployment.Status.ObservedGeneration != 0 && canaryDeployment.Generation != canaryDeployment.Status.ObservedGeneration &&
+		canaryDeployment.Status.Phase != v1.CanaryDeploymentPhaseInitializing {
+		s.logger.Info("CanaryDeployment spec changed, moving to phase Initializing", "canaryDeployment", canaryDeployment.Namespace+"."+canaryDeployment.Name, "generation", canaryDeployment.Generation, "observedGeneration", canaryDeployment.Status.ObservedGeneration)
+		s.transition(canaryDeployment, v1.CanaryDeploymentPhaseInitializing, scheduler.ReasonSpecChanged)
+	}
 
 	logger := s.logger.WithValues(
 		"canaryDeployment", canaryDeployment.Namespace+"."+canaryDeployment.Name,
@@ -148,98 +200,299 @@ func (s *Scheduler) Reconcile(request reconcile.Request) (reconcile.Result, erro
 	)
 
 	switch canaryDeployment.Status.Phase {
-	case "", v1.CanaryDeploymentPhaseInitializing: // begin worker phase
+	case v1.CanaryDeploymentPhaseInitializing: // begin worker phase
 		logger.Info("Syncing CanaryDeployment in phase Initializing", "name", canaryDeployment.Name)
 
 		worker := &initializing.Worker{
-			Client: client,
-			Logger: logger,
+			Client:   client,
+			Logger:   logger,
+			Recorder: s.recorder,
 		}
-		inputs, err := s.makeInitializingInputs(client)
+		inputs, err := s.makeInitializingInputs(client, canaryDeployment)
 		if err != nil {
 			return result, fmt.Errorf("failed to make InitializingInputs: %v", err)
 		}
-		outputs, nextPhase, statusInfo, err := worker.Sync(s.ctx, canaryDeployment, inputs)
+
+		var (
+			outputs      initializing.Outputs
+			nextPhase    v1.CanaryDeploymentPhase
+			requeueAfter time.Duration
+			statusInfo   *v1.CanaryDeploymentStatusInfo
+		)
+
+		// a panic in the worker is recovered and handled as a worker error
+		err = scheduler.RunWorker(logger, func() error {
+			var err error
+			outputs, nextPhase, requeueAfter, statusInfo, err = worker.Sync(s.ctx, canaryDeployment, inputs)
+			return err
+		})
 		if err != nil {
-			return result, fmt.Errorf("failed to run worker for phase Initializing: %v", err)
+			reason := scheduler.WorkerErrorReason(err)
+			err = fmt.Errorf("failed to run worker for phase Initializing: %v", err)
+			s.recordSyncFailure(canaryDeployment, reason, err)
+			canaryDeployment.Status.RecordFailure(err)
+			if statusErr := s.updateStatus(client, canaryDeployment, status); statusErr != nil {
+				logger.Error(statusErr, "Failed to record worker failure")
+			}
+			return result, err
+		}
+
+		// refuse transitions which are not declared in the phase graph
+		if !v1.CanaryDeploymentPhaseInitializing.CanTransitionTo(nextPhase) {
+			err := fmt.Errorf("worker for phase Initializing returned illegal transition to phase %v", nextPhase)
+			logger.Error(err, "Refusing phase transition, ignoring worker results")
+			s.recordSyncFailure(canaryDeployment, scheduler.ReasonIllegalTransition, err)
+			return result, s.updateStatus(client, canaryDeployment, status)
 		}
 		for _, out := range outputs.Deployments.Items {
-			if err := client.Ensure(s.ctx, canaryDeployment, &out); err != nil {
+			written, err := client.Ensure(s.ctx, canaryDeployment, &out)
+			if err != nil {
 				return result, fmt.Errorf("failed to write output Deployment<%v.%v> for phase Initializing: %v", out.GetNamespace(), out.GetName(), err)
 			}
+			if written {
+				logger.Info("Wrote output", "kind", "Deployment", "name", out.GetName(), "namespace", out.GetNamespace())
+			}
 		}
 		for _, out := range outputs.Services.Items {
-			if err := client.Ensure(s.ctx, canaryDeployment, &out); err != nil {
+			written, err := client.Ensure(s.ctx, canaryDeployment, &out)
+			if err != nil {
 				return result, fmt.Errorf("failed to write output Service<%v.%v> for phase Initializing: %v", out.GetNamespace(), out.GetName(), err)
 			}
+			if written {
+				logger.Info("Wrote output", "kind", "Service", "name", out.GetName(), "namespace", out.GetNamespace())
+			}
 		}
 		for _, out := range outputs.VirtualServices.Items {
-			if err := client.Ensure(s.ctx, canaryDeployment, &out); err != nil {
+			written, err := client.Ensure(s.ctx, canaryDeployment, &out)
+			if err != nil {
 				return result, fmt.Errorf("failed to write output VirtualService<%v.%v> for phase Initializing: %v", out.GetNamespace(), out.GetName(), err)
 			}
+			if written {
+				logger.Info("Wrote output", "kind", "VirtualService", "name", out.GetName(), "namespace", out.GetNamespace())
+			}
+		}
+		if len(outputs.Delete.Deployments.Items) > 0 {
+			logger.Info("Deleting outputs", "kind", "Deployment", "count", len(outputs.Delete.Deployments.Items))
+			if err := scheduler.DeleteOutputs(s.ctx, client, &outputs.Delete.Deployments); err != nil {
+				return result, fmt.Errorf("failed to delete output Deployments for phase Initializing: %v", err)
+			}
+		}
+		if len(outputs.Delete.Services.Items) > 0 {
+			logger.Info("Deleting outputs", "kind", "Service", "count", len(outputs.Delete.Services.Items))
+			if err := scheduler.DeleteOutputs(s.ctx, client, &outputs.Delete.Services); err != nil {
+				return result, fmt.Errorf("failed to delete output Services for phase Initializing: %v", err)
+			}
+		}
+		if len(outputs.Delete.VirtualServices.Items) > 0 {
+			logger.Info("Deleting outputs", "kind", "VirtualService", "count", len(outputs.Delete.VirtualServices.Items))
+			if err := scheduler.DeleteOutputs(s.ctx, client, &outputs.Delete.VirtualServices); err != nil {
+				return result, fmt.Errorf("failed to delete output VirtualServices for phase Initializing: %v", err)
+			}
+		}
+
+		// honor the worker's requeue hint
+		if requeueAfter > 0 {
+			result.RequeueAfter = requeueAfter
 		}
 
 		// update the CanaryDeployment status with the worker's results
-		canaryDeployment.Status.Phase = nextPhase
+		canaryDeployment.Status.MarkConditionTrue(v1.CanaryDeploymentConditionSynced, canaryDeployment.Generation, scheduler.ReasonWorkerSucceeded, "")
+		canaryDeployment.Status.ResetFailures()
+		if nextPhase != canaryDeployment.Status.Phase {
+			s.transition(canaryDeployment, nextPhase, scheduler.ReasonWorkerTransition)
+		}
 		if statusInfo != nil {
 			logger.Info("Updating status of primary resource")
 			canaryDeployment.Status.CanaryDeploymentStatusInfo = *statusInfo
 		}
 	case v1.CanaryDeploymentPhaseWaiting: // begin worker phase
+		// Waiting resyncs every 30s
+		result.RequeueAfter = time.Duration(30000000000)
 		logger.Info("Syncing CanaryDeployment in phase Waiting", "name", canaryDeployment.Name)
 
 		worker := &waiting.Worker{
-			Client: client,
-			Logger: logger,
+			Client:   client,
+			Logger:   logger,
+			Recorder: s.recorder,
 		}
-		inputs, err := s.makeWaitingInputs(client)
+		inputs, err := s.makeWaitingInputs(client, canaryDeployment)
 		if err != nil {
 			return result, fmt.Errorf("failed to make WaitingInputs: %v", err)
 		}
-		outputs, nextPhase, statusInfo, err := worker.Sync(s.ctx, canaryDeployment, inputs)
+
+		var (
+			outputs      waiting.Outputs
+			nextPhase    v1.CanaryDeploymentPhase
+			requeueAfter time.Duration
+			statusInfo   *v1.CanaryDeploymentStatusInfo
+		)
+
+		// a panic in the worker is recovered and handled as a worker error
+		err = scheduler.RunWorker(logger, func() error {
+			var err error
+			outputs, nextPhase, requeueAfter, statusInfo, err = worker.Sync(s.ctx, canaryDeployment, inputs)
+			return err
+		})
 		if err != nil {
-			return result, fmt.Errorf("failed to run worker for phase Waiting: %v", err)
+			reason := scheduler.WorkerErrorReason(err)
+			err = fmt.Errorf("failed to run worker for phase Waiting: %v", err)
+			s.recordSyncFailure(canaryDeployment, reason, err)
+			canaryDeployment.Status.RecordFailure(err)
+			if statusErr := s.updateStatus(client, canaryDeployment, status); statusErr != nil {
+				logger.Error(statusErr, "Failed to record worker failure")
+			}
+			return result, err
+		}
+
+		// refuse transitions which are not declared in the phase graph
+		if !v1.CanaryDeploymentPhaseWaiting.CanTransitionTo(nextPhase) {
+			err := fmt.Errorf("worker for phase Waiting returned illegal transition to phase %v", nextPhase)
+			logger.Error(err, "Refusing phase transition, ignoring worker results")
+			s.recordSyncFailure(canaryDeployment, scheduler.ReasonIllegalTransition, err)
+			return result, s.updateStatus(client, canaryDeployment, status)
 		}
 		for _, out := range outputs.Deployments.Items {
-			if err := client.Ensure(s.ctx, canaryDeployment, &out); err != nil {
+			written, err := client.Ensure(s.ctx, canaryDeployment, &out)
+			if err != nil {
 				return result, fmt.Errorf("failed to write output Deployment<%v.%v> for phase Waiting: %v", out.GetNamespace(), out.GetName(), err)
 			}
+			if written {
+				logger.Info("Wrote output", "kind", "Deployment", "name", out.GetName(), "namespace", out.GetNamespace())
+			}
 		}
 		for _, out := range outputs.VirtualServices.Items {
-			if err := client.Ensure(s.ctx, canaryDeployment, &out); err != nil {
+			written, err := client.Ensure(s.ctx, canaryDeployment, &out)
+			if err != nil {
 				return result, fmt.Errorf("failed to write output VirtualService<%v.%v> for phase Waiting: %v", out.GetNamespace(), out.GetName(), err)
 			}
+			if written {
+				logger.Info("Wrote output", "kind", "VirtualService", "name", out.GetName(), "namespace", out.GetNamespace())
+			}
+		}
+		if len(outputs.Delete.Deployments.Items) > 0 {
+			logger.Info("Deleting outputs", "kind", "Deployment", "count", len(outputs.Delete.Deployments.Items))
+			if err := scheduler.DeleteOutputs(s.ctx, client, &outputs.Delete.Deployments); err != nil {
+				return result, fmt.Errorf("failed to delete output Deployments for phase Waiting: %v", err)
+			}
+		}
+		if len(outputs.Delete.VirtualServices.Items) > 0 {
+			logger.Info("Deleting outputs", "kind", "VirtualService", "count", len(outputs.Delete.VirtualServices.Items))
+			if err := scheduler.DeleteOutputs(s.ctx, client, &outputs.Delete.VirtualServices); err != nil {
+				return result, fmt.Errorf("failed to delete output VirtualServices for phase Waiting: %v", err)
+			}
+		}
+
+		// honor the worker's requeue hint
+		if requeueAfter > 0 {
+			result.RequeueAfter = requeueAfter
 		}
 
 		// update the CanaryDeployment status with the worker's results
-		canaryDeployment.Status.Phase = nextPhase
+		canaryDeployment.Status.MarkConditionTrue(v1.CanaryDeploymentConditionSynced, canaryDeployment.Generation, scheduler.ReasonWorkerSucceeded, "")
+		canaryDeployment.Status.ResetFailures()
+		if nextPhase != canaryDeployment.Status.Phase {
+			s.transition(canaryDeployment, nextPhase, scheduler.ReasonWorkerTransition)
+		}
 		if statusInfo != nil {
 			logger.Info("Updating status of primary resource")
 			canaryDeployment.Status.CanaryDeploymentStatusInfo = *statusInfo
 		}
 	case v1.CanaryDeploymentPhaseEvaluating: // begin worker phase
+		// Evaluating times out after 10m0s
+		timeout := time.Duration(600000000000)
+		timeLeft := timeout - time.Since(canaryDeployment.Status.PhaseEntryTime.Time)
+		if timeLeft <= 0 {
+			logger.Info("CanaryDeployment timed out in phase Evaluating, transitioning to RollBack", "name", canaryDeployment.Name, "timeout", timeout)
+			s.transition(canaryDeployment, v1.CanaryDeploymentPhaseRollBack, scheduler.ReasonPhaseTimeout)
+			break
+		}
 		logger.Info("Syncing CanaryDeployment in phase Evaluating", "name", canaryDeployment.Name)
 
 		worker := &evaluating.Worker{
-			Client: client,
-			Logger: logger,
+			Client:   client,
+			Logger:   logger,
+			Recorder: s.recorder,
 		}
-		inputs, err := s.makeEvaluatingInputs(client)
+		inputs, err := s.makeEvaluatingInputs(client, canaryDeployment)
 		if err != nil {
 			return result, fmt.Errorf("failed to make EvaluatingInputs: %v", err)
 		}
-		outputs, nextPhase, statusInfo, err := worker.Sync(s.ctx, canaryDeployment, inputs)
+
+		var (
+			outputs      evaluating.Outputs
+			nextPhase    v1.CanaryDeploymentPhase
+			requeueAfter time.Duration
+			statusInfo   *v1.CanaryDeploymentStatusInfo
+		)
+
+		// a panic in the worker is recovered and handled as a worker error
+		err = scheduler.RunWorker(logger, func() error {
+			var err error
+			outputs, nextPhase, requeueAfter, statusInfo, err = worker.Sync(s.ctx, canaryDeployment, inputs)
+			return err
+		})
 		if err != nil {
-			return result, fmt.Errorf("failed to run worker for phase Evaluating: %v", err)
+			reason := scheduler.WorkerErrorReason(err)
+			err = fmt.Errorf("failed to run worker for phase Evaluating: %v", err)
+			s.recordSyncFailure(canaryDeployment, reason, err)
+			canaryDeployment.Status.RecordFailure(err)
+
+			// give up on the worker once it has exhausted its retries
+			if canaryDeployment.Status.FailureCount > 5 {
+				logger.Error(err, "Worker for phase Evaluating exceeded 5 retries, transitioning to RollBack", "failures", canaryDeployment.Status.FailureCount)
+				s.transition(canaryDeployment, v1.CanaryDeploymentPhaseRollBack, scheduler.ReasonRetriesExhausted)
+				return result, s.updateStatus(client, canaryDeployment, status)
+			}
+
+			// back off before retrying the worker
+			result.RequeueAfter = scheduler.Backoff{
+				InitialInterval: time.Duration(5000000000),
+				MaxInterval:     time.Duration(60000000000),
+				Multiplier:      2,
+			}.Interval(canaryDeployment.Status.FailureCount)
+			logger.Error(err, "Worker for phase Evaluating failed, retrying", "failures", canaryDeployment.Status.FailureCount, "requeueAfter", result.RequeueAfter)
+			return result, s.updateStatus(client, canaryDeployment, status)
+		}
+
+		// refuse transitions which are not declared in the phase graph
+		if !v1.CanaryDeploymentPhaseEvaluating.CanTransitionTo(nextPhase) {
+			err := fmt.Errorf("worker for phase Evaluating returned illegal transition to phase %v", nextPhase)
+			logger.Error(err, "Refusing phase transition, ignoring worker results")
+			s.recordSyncFailure(canaryDeployment, scheduler.ReasonIllegalTransition, err)
+			return result, s.updateStatus(client, canaryDeployment, status)
 		}
 		for _, out := range outputs.VirtualServices.Items {
-			if err := client.Ensure(s.ctx, canaryDeployment, &out); err != nil {
+			written, err := client.Ensure(s.ctx, canaryDeployment, &out)
+			if err != nil {
 				return result, fmt.Errorf("failed to write output VirtualService<%v.%v> for phase Evaluating: %v", out.GetNamespace(), out.GetName(), err)
 			}
+			if written {
+				logger.Info("Wrote output", "kind", "VirtualService", "name", out.GetName(), "namespace", out.GetNamespace())
+			}
+		}
+		if len(outputs.Delete.VirtualServices.Items) > 0 {
+			logger.Info("Deleting outputs", "kind", "VirtualService", "count", len(outputs.Delete.VirtualServices.Items))
+			if err := scheduler.DeleteOutputs(s.ctx, client, &outputs.Delete.VirtualServices); err != nil {
+				return result, fmt.Errorf("failed to delete output VirtualServices for phase Evaluating: %v", err)
+			}
+		}
+
+		// honor the worker's requeue hint
+		if requeueAfter > 0 {
+			result.RequeueAfter = requeueAfter
+		}
+
+		// requeue in time to enforce the timeout
+		if nextPhase == v1.CanaryDeploymentPhaseEvaluating && timeLeft < result.RequeueAfter {
+			result.RequeueAfter = timeLeft
 		}
 
 		// update the CanaryDeployment status with the worker's results
-		canaryDeployment.Status.Phase = nextPhase
+		canaryDeployment.Status.MarkConditionTrue(v1.CanaryDeploymentConditionSynced, canaryDeployment.Generation, scheduler.ReasonWorkerSucceeded, "")
+		canaryDeployment.Status.ResetFailures()
+		if nextPhase != canaryDeployment.Status.Phase {
+			s.transition(canaryDeployment, nextPhase, scheduler.ReasonWorkerTransition)
+		}
 		if statusInfo != nil {
 			logger.Info("Updating status of primary resource")
 			canaryDeployment.Status.CanaryDeploymentStatusInfo = *statusInfo
@@ -248,30 +501,88 @@ func (s *Scheduler) Reconcile(request reconcile.Request) (reconcile.Result, erro
 		logger.Info("Syncing CanaryDeployment in phase Promoting", "name", canaryDeployment.Name)
 
 		worker := &promoting.Worker{
-			Client: client,
-			Logger: logger,
+			Client:   client,
+			Logger:   logger,
+			Recorder: s.recorder,
 		}
-		inputs, err := s.makePromotingInputs(client)
+		inputs, err := s.makePromotingInputs(client, canaryDeployment)
 		if err != nil {
 			return result, fmt.Errorf("failed to make PromotingInputs: %v", err)
 		}
-		outputs, nextPhase, statusInfo, err := worker.Sync(s.ctx, canaryDeployment, inputs)
+
+		var (
+			outputs      promoting.Outputs
+			nextPhase    v1.CanaryDeploymentPhase
+			requeueAfter time.Duration
+			statusInfo   *v1.CanaryDeploymentStatusInfo
+		)
+
+		// a panic in the worker is recovered and handled as a worker error
+		err = scheduler.RunWorker(logger, func() error {
+			var err error
+			outputs, nextPhase, requeueAfter, statusInfo, err = worker.Sync(s.ctx, canaryDeployment, inputs)
+			return err
+		})
 		if err != nil {
-			return result, fmt.Errorf("failed to run worker for phase Promoting: %v", err)
+			reason := scheduler.WorkerErrorReason(err)
+			err = fmt.Errorf("failed to run worker for phase Promoting: %v", err)
+			s.recordSyncFailure(canaryDeployment, reason, err)
+			canaryDeployment.Status.RecordFailure(err)
+			if statusErr := s.updateStatus(client, canaryDeployment, status); statusErr != nil {
+				logger.Error(statusErr, "Failed to record worker failure")
+			}
+			return result, err
+		}
+
+		// refuse transitions which are not declared in the phase graph
+		if !v1.CanaryDeploymentPhasePromoting.CanTransitionTo(nextPhase) {
+			err := fmt.Errorf("worker for phase Promoting returned illegal transition to phase %v", nextPhase)
+			logger.Error(err, "Refusing phase transition, ignoring worker results")
+			s.recordSyncFailure(canaryDeployment, scheduler.ReasonIllegalTransition, err)
+			return result, s.updateStatus(client, canaryDeployment, status)
 		}
 		for _, out := range outputs.Deployments.Items {
-			if err := client.Ensure(s.ctx, canaryDeployment, &out); err != nil {
+			written, err := client.Ensure(s.ctx, canaryDeployment, &out)
+			if err != nil {
 				return result, fmt.Errorf("failed to write output Deployment<%v.%v> for phase Promoting: %v", out.GetNamespace(), out.GetName(), err)
 			}
+			if written {
+				logger.Info("Wrote output", "kind", "Deployment", "name", out.GetName(), "namespace", out.GetNamespace())
+			}
 		}
 		for _, out := range outputs.VirtualServices.Items {
-			if err := client.Ensure(s.ctx, canaryDeployment, &out); err != nil {
+			written, err := client.Ensure(s.ctx, canaryDeployment, &out)
+			if err != nil {
 				return result, fmt.Errorf("failed to write output VirtualService<%v.%v> for phase Promoting: %v", out.GetNamespace(), out.GetName(), err)
 			}
+			if written {
+				logger.Info("Wrote output", "kind", "VirtualService", "name", out.GetName(), "namespace", out.GetNamespace())
+			}
+		}
+		if len(outputs.Delete.Deployments.Items) > 0 {
+			logger.Info("Deleting outputs", "kind", "Deployment", "count", len(outputs.Delete.Deployments.Items))
+			if err := scheduler.DeleteOutputs(s.ctx, client, &outputs.Delete.Deployments); err != nil {
+				return result, fmt.Errorf("failed to delete output Deployments for phase Promoting: %v", err)
+			}
+		}
+		if len(outputs.Delete.VirtualServices.Items) > 0 {
+			logger.Info("Deleting outputs", "kind", "VirtualService", "count", len(outputs.Delete.VirtualServices.Items))
+			if err := scheduler.DeleteOutputs(s.ctx, client, &outputs.Delete.VirtualServices); err != nil {
+				return result, fmt.Errorf("failed to delete output VirtualServices for phase Promoting: %v", err)
+			}
+		}
+
+		// honor the worker's requeue hint
+		if requeueAfter > 0 {
+			result.RequeueAfter = requeueAfter
 		}
 
 		// update the CanaryDeployment status with the worker's results
-		canaryDeployment.Status.Phase = nextPhase
+		canaryDeployment.Status.MarkConditionTrue(v1.CanaryDeploymentConditionSynced, canaryDeployment.Generation, scheduler.ReasonWorkerSucceeded, "")
+		canaryDeployment.Status.ResetFailures()
+		if nextPhase != canaryDeployment.Status.Phase {
+			s.transition(canaryDeployment, nextPhase, scheduler.ReasonWorkerTransition)
+		}
 		if statusInfo != nil {
 			logger.Info("Updating status of primary resource")
 			canaryDeployment.Status.CanaryDeploymentStatusInfo = *statusInfo
@@ -280,30 +591,88 @@ func (s *Scheduler) Reconcile(request reconcile.Request) (reconcile.Result, erro
 		logger.Info("Syncing CanaryDeployment in phase RollBack", "name", canaryDeployment.Name)
 
 		worker := &rollback.Worker{
-			Client: client,
-			Logger: logger,
+			Client:   client,
+			Logger:   logger,
+			Recorder: s.recorder,
 		}
-		inputs, err := s.makeRollBackInputs(client)
+		inputs, err := s.makeRollBackInputs(client, canaryDeployment)
 		if err != nil {
 			return result, fmt.Errorf("failed to make RollBackInputs: %v", err)
 		}
-		outputs, nextPhase, statusInfo, err := worker.Sync(s.ctx, canaryDeployment, inputs)
+
+		var (
+			outputs      rollback.Outputs
+			nextPhase    v1.CanaryDeploymentPhase
+			requeueAfter time.Duration
+			statusInfo   *v1.CanaryDeploymentStatusInfo
+		)
+
+		// a panic in the worker is recovered and handled as a worker error
+		err = scheduler.RunWorker(logger, func() error {
+			var err error
+			outputs, nextPhase, requeueAfter, statusInfo, err = worker.Sync(s.ctx, canaryDeployment, inputs)
+			return err
+		})
 		if err != nil {
-			return result, fmt.Errorf("failed to run worker for phase RollBack: %v", err)
+			reason := scheduler.WorkerErrorReason(err)
+			err = fmt.Errorf("failed to run worker for phase RollBack: %v", err)
+			s.recordSyncFailure(canaryDeployment, reason, err)
+			canaryDeployment.Status.RecordFailure(err)
+			if statusErr := s.updateStatus(client, canaryDeployment, status); statusErr != nil {
+				logger.Error(statusErr, "Failed to record worker failure")
+			}
+			return result, err
+		}
+
+		// refuse transitions which are not declared in the phase graph
+		if !v1.CanaryDeploymentPhaseRollBack.CanTransitionTo(nextPhase) {
+			err := fmt.Errorf("worker for phase RollBack returned illegal transition to phase %v", nextPhase)
+			logger.Error(err, "Refusing phase transition, ignoring worker results")
+			s.recordSyncFailure(canaryDeployment, scheduler.ReasonIllegalTransition, err)
+			return result, s.updateStatus(client, canaryDeployment, status)
 		}
 		for _, out := range outputs.Deployments.Items {
-			if err := client.Ensure(s.ctx, canaryDeployment, &out); err != nil {
+			written, err := client.Ensure(s.ctx, canaryDeployment, &out)
+			if err != nil {
 				return result, fmt.Errorf("failed to write output Deployment<%v.%v> for phase RollBack: %v", out.GetNamespace(), out.GetName(), err)
 			}
+			if written {
+				logger.Info("Wrote output", "kind", "Deployment", "name", out.GetName(), "namespace", out.GetNamespace())
+			}
 		}
 		for _, out := range outputs.VirtualServices.Items {
-			if err := client.Ensure(s.ctx, canaryDeployment, &out); err != nil {
+			written, err := client.Ensure(s.ctx, canaryDeployment, &out)
+			if err != nil {
 				return result, fmt.Errorf("failed to write output VirtualService<%v.%v> for phase RollBack: %v", out.GetNamespace(), out.GetName(), err)
 			}
+			if written {
+				logger.Info("Wrote output", "kind", "VirtualService", "name", out.GetName(), "namespace", out.GetNamespace())
+			}
+		}
+		if len(outputs.Delete.Deployments.Items) > 0 {
+			logger.Info("Deleting outputs", "kind", "Deployment", "count", len(outputs.Delete.Deployments.Items))
+			if err := scheduler.DeleteOutputs(s.ctx, client, &outputs.Delete.Deployments); err != nil {
+				return result, fmt.Errorf("failed to delete output Deployments for phase RollBack: %v", err)
+			}
+		}
+		if len(outputs.Delete.VirtualServices.Items) > 0 {
+			logger.Info("Deleting outputs", "kind", "VirtualService", "count", len(outputs.Delete.VirtualServices.Items))
+			if err := scheduler.DeleteOutputs(s.ctx, client, &outputs.Delete.VirtualServices); err != nil {
+				return result, fmt.Errorf("failed to delete output VirtualServices for phase RollBack: %v", err)
+			}
+		}
+
+		// honor the worker's requeue hint
+		if requeueAfter > 0 {
+			result.RequeueAfter = requeueAfter
 		}
 
 		// update the CanaryDeployment status with the worker's results
-		canaryDeployment.Status.Phase = nextPhase
+		canaryDeployment.Status.MarkConditionTrue(v1.CanaryDeploymentConditionSynced, canaryDeployment.Generation, scheduler.ReasonWorkerSucceeded, "")
+		canaryDeployment.Status.ResetFailures()
+		if nextPhase != canaryDeployment.Status.Phase {
+			s.transition(canaryDeployment, nextPhase, scheduler.ReasonWorkerTransition)
+		}
 		if statusInfo != nil {
 			logger.Info("Updating status of primary resource")
 			canaryDeployment.Status.CanaryDeploymentStatusInfo = *statusInfo
@@ -313,18 +682,70 @@ func (s *Scheduler) Reconcile(request reconcile.Request) (reconcile.Result, erro
 		return result, fmt.Errorf("cannot process CanaryDeployment in unknown phase: %v", canaryDeployment.Status.Phase)
 	}
 
-	canaryDeployment.Status.ObservedGeneration = canaryDeployment.Generation
+	return result, s.updateStatus(client, canaryDeployment, status)
+}
 
-	if !reflect.DeepEqual(status, canaryDeployment.Status) {
-		if err := client.UpdateStatus(s.ctx, canaryDeployment); err != nil {
-			return result, fmt.Errorf("failed to update CanaryDeploymentStatus: %v", err)
-		}
+// update the status of the CanaryDeployment if it has changed from the original
+func (s *Scheduler) updateStatus(client ezkube.Client, canaryDeployment *v1.CanaryDeployment, original v1.CanaryDeploymentStatus) error {
+	// the generation of a paused CanaryDeployment is observed once it is resumed
+	if !scheduler.IsPaused(canaryDeployment) {
+		canaryDeployment.Status.ObservedGeneration = canaryDeployment.Generation
+	}
+
+	if reflect.DeepEqual(original, canaryDeployment.Status) {
+		return nil
+	}
+	if err := client.UpdateStatus(s.ctx, canaryDeployment); err != nil {
+		return fmt.Errorf("failed to update CanaryDeploymentStatus: %v", err)
+	}
+	return nil
+}
+
+// transition the CanaryDeployment to the phase requested by the force-phase annotation, after removing the annotation.
+// the forced phase must be a transition permitted by the phase graph; other phases are refused with a Warning event.
+func (s *Scheduler) forcePhase(client ezkube.Client, canaryDeployment *v1.CanaryDeployment, forcedPhase v1.CanaryDeploymentPhase) error {
+	// remove the annotation first so the phase is only forced once,
+	// even if the status update below fails
+	status := canaryDeployment.Status
+	scheduler.RemoveForcedPhase(canaryDeployment)
+	if err := client.Update(s.ctx, canaryDeployment); err != nil {
+		return fmt.Errorf("failed to remove %v annotation: %v", scheduler.ForcePhaseAnnotation, err)
 	}
+	canaryDeployment.Status = status
 
-	return result, nil
+	if !forcedPhase.IsValid() {
+		s.recorder.Eventf(canaryDeployment, corev1.EventTypeWarning, scheduler.ReasonForcePhase, "Ignoring unknown phase %v in %v annotation", forcedPhase, scheduler.ForcePhaseAnnotation)
+		return nil
+	}
+	if !canaryDeployment.Status.Phase.CanTransitionTo(forcedPhase) {
+		s.logger.Info("refusing forced CanaryDeployment phase", "canaryDeployment", canaryDeployment.Namespace+"."+canaryDeployment.Name, "phase", canaryDeployment.Status.Phase, "forcedPhase", forcedPhase)
+		s.recorder.Eventf(canaryDeployment, corev1.EventTypeWarning, scheduler.ReasonForcePhase, "Refusing transition from phase %v to %v requested by %v annotation", canaryDeployment.Status.Phase, forcedPhase, scheduler.ForcePhaseAnnotation)
+		return nil
+	}
+
+	s.logger.Info("forcing CanaryDeployment phase", "canaryDeployment", canaryDeployment.Namespace+"."+canaryDeployment.Name, "phase", forcedPhase)
+	s.transition(canaryDeployment, forcedPhase, scheduler.ReasonForcePhase)
+	// the generation is not observed here, so a spec change made along with the annotation
+	// is still handled by the onSpecChange policy on the next sync
+	if err := client.UpdateStatus(s.ctx, canaryDeployment); err != nil {
+		return fmt.Errorf("failed to update CanaryDeploymentStatus: %v", err)
+	}
+	return nil
+}
+
+// transition the CanaryDeployment to the next phase and record a Normal event for the transition
+func (s *Scheduler) transition(canaryDeployment *v1.CanaryDeployment, nextPhase v1.CanaryDeploymentPhase, reason string) {
+	s.recorder.Eventf(canaryDeployment, corev1.EventTypeNormal, reason, "Transitioned from phase %v to %v", canaryDeployment.Status.Phase, nextPhase)
+	canaryDeployment.Status.TransitionTo(nextPhase, reason)
 }
 
-func (s *Scheduler) makeInitializingInputs(client ezkube.Client) (initializing.Inputs, error) {
+// record a failed sync in the Synced condition of the CanaryDeployment and as a Warning event
+func (s *Scheduler) recordSyncFailure(canaryDeployment *v1.CanaryDeployment, reason string, err error) {
+	s.recorder.Event(canaryDeployment, corev1.EventTypeWarning, reason, err.Error())
+	canaryDeployment.Status.MarkConditionFalse(v1.CanaryDeploymentConditionSynced, canaryDeployment.Generation, reason, err.Error())
+}
+
+func (s *Scheduler) makeInitializingInputs(client ezkube.Client, canaryDeployment *v1.CanaryDeployment) (initializing.Inputs, error) {
 	var (
 		inputs initializing.Inputs
 		err    error
@@ -334,10 +755,12 @@ func (s *Scheduler) makeInitializingInputs(client ezkube.Client) (initializing.I
 		return inputs, err
 	}
 
+	inputs.BuildIndexes()
+
 	return inputs, err
 }
 
-func (s *Scheduler) makeWaitingInputs(client ezkube.Client) (waiting.Inputs, error) {
+func (s *Scheduler) makeWaitingInputs(client ezkube.Client, canaryDeployment *v1.CanaryDeployment) (waiting.Inputs, error) {
 	var (
 		inputs waiting.Inputs
 		err    error
@@ -351,10 +774,12 @@ func (s *Scheduler) makeWaitingInputs(client ezkube.Client) (waiting.Inputs, err
 		return inputs, err
 	}
 
+	inputs.BuildIndexes()
+
 	return inputs, err
 }
 
-func (s *Scheduler) makeEvaluatingInputs(client ezkube.Client) (evaluating.Inputs, error) {
+func (s *Scheduler) makeEvaluatingInputs(client ezkube.Client, canaryDeployment *v1.CanaryDeployment) (evaluating.Inputs, error) {
 	var (
 		inputs evaluating.Inputs
 		err    error
@@ -365,10 +790,12 @@ func (s *Scheduler) makeEvaluatingInputs(client ezkube.Client) (evaluating.Input
 		return inputs, err
 	}
 
+	inputs.BuildIndexes()
+
 	return inputs, err
 }
 
-func (s *Scheduler) makePromotingInputs(client ezkube.Client) (promoting.Inputs, error) {
+func (s *Scheduler) makePromotingInputs(client ezkube.Client, canaryDeployment *v1.CanaryDeployment) (promoting.Inputs, error) {
 	var (
 		inputs promoting.Inputs
 		err    error
@@ -382,10 +809,12 @@ func (s *Scheduler) makePromotingInputs(client ezkube.Client) (promoting.Inputs,
 		return inputs, err
 	}
 
+	inputs.BuildIndexes()
+
 	return inputs, err
 }
 
-func (s *Scheduler) makeRollBackInputs(client ezkube.Client) (rollback.Inputs, error) {
+func (s *Scheduler) makeRollBackInputs(client ezkube.Client, canaryDeployment *v1.CanaryDeployment) (rollback.Inputs, error) {
 	var (
 		inputs rollback.Inputs
 		err    error
@@ -399,5 +828,7 @@ func (s *Scheduler) makeRollBackInputs(client ezkube.Client) (rollback.Inputs, e
 		return inputs, err
 	}
 
+	inputs.BuildIndexes()
+
 	return inputs, err
 }
@@ -3,6 +3,9 @@
 package evaluating
 
 import (
+	"github.com/solo-io/autopilot/pkg/scheduler"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	canarydeploymentmetrics "github.com/solo-io/autopilot/test/e2e/canary/pkg/metrics"
 	parameters "github.com/solo-io/autopilot/test/e2e/canary/pkg/parameters"
 )
@@ -10,18 +13,63 @@ import (
 type Inputs struct {
 	Metrics         canarydeploymentmetrics.CanaryDeploymentMetrics
 	VirtualServices parameters.VirtualServices
+
+	// map-backed indexes built by BuildIndexes
+	virtualServicesIndex *scheduler.InputIndex
+}
+
+// BuildIndexes builds the map-backed indexes used to look up the Inputs.
+// It is called by the scheduler before the Inputs are passed to the worker.
+// Inputs constructed elsewhere (e.g. in tests) build their indexes on the first lookup,
+// and rebuild them when the number of Items changes.
+func (i *Inputs) BuildIndexes() {
+	i.virtualServicesIndex = scheduler.NewInputIndex(len(i.VirtualServices.Items), func(idx int) metav1.Object {
+		return &i.VirtualServices.Items[idx]
+	})
+}
+
+func (i *Inputs) indexVirtualServices() *scheduler.InputIndex {
+	if i.virtualServicesIndex == nil || i.virtualServicesIndex.Len() != len(i.VirtualServices.Items) {
+		i.BuildIndexes()
+	}
+	return i.virtualServicesIndex
 }
 
 // FindVirtualService returns <VirtualService, true> if the item is found. else parameters.VirtualService{}, false
-func (i Inputs) FindVirtualService(name, namespace string) (parameters.VirtualService, bool) {
-	for _, item := range i.VirtualServices.Items {
-		if item.Name == name && item.Namespace == namespace {
-			return item, true
-		}
+func (i *Inputs) FindVirtualService(name, namespace string) (parameters.VirtualService, bool) {
+	if idx, ok := i.indexVirtualServices().Find(name, namespace); ok {
+		return i.VirtualServices.Items[idx], true
 	}
 	return parameters.VirtualService{}, false
 }
 
+// ByVirtualServiceLabel returns the VirtualServices whose label key has the given value
+func (i *Inputs) ByVirtualServiceLabel(key, value string) []parameters.VirtualService {
+	var items []parameters.VirtualService
+	for _, idx := range i.indexVirtualServices().ByLabel(key, value) {
+		items = append(items, i.VirtualServices.Items[idx])
+	}
+	return items
+}
+
+// VirtualServicesOwnedBy returns the VirtualServices with an owner reference to the given owner
+func (i *Inputs) VirtualServicesOwnedBy(owner metav1.Object) []parameters.VirtualService {
+	var items []parameters.VirtualService
+	for _, idx := range i.indexVirtualServices().OwnedBy(owner.GetUID()) {
+		items = append(items, i.VirtualServices.Items[idx])
+	}
+	return items
+}
+
 type Outputs struct {
 	VirtualServices parameters.VirtualServices
+
+	// objects for the scheduler to delete after writing the outputs above
+	Delete Deletions
+}
+
+// Deletions are deleted by the scheduler after it writes the Outputs.
+// Objects which no longer exist are ignored.
+type Deletions struct {
+	VirtualServices parameters.VirtualServices
 }
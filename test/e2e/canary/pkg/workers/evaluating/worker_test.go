@@ -0,0 +1,134 @@
+package evaluating
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/solo-io/autopilot/pkg/metrics"
+	metricsfake "github.com/solo-io/autopilot/pkg/metrics/fake"
+	v1 "github.com/solo-io/autopilot/test/e2e/canary/pkg/apis/canarydeployments/v1"
+	canarydeploymentmetrics "github.com/solo-io/autopilot/test/e2e/canary/pkg/metrics"
+	parameters "github.com/solo-io/autopilot/test/e2e/canary/pkg/parameters"
+	"github.com/solo-io/autopilot/test/e2e/canary/pkg/weights"
+	"github.com/solo-io/autopilot/test/e2e/canary/pkg/workers/workertest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+)
+
+func TestSync(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+
+		// the canned result of the success rate query, or no data if nil
+		successRate *metrics.QueryResult
+
+		// how long the CanaryDeployment has been evaluated
+		elapsed time.Duration
+
+		expectedPhase v1.CanaryDeploymentPhase
+		expectedErr   error
+
+		// the weights of the virtual service written by the worker, if any
+		expectedWeights []int32
+	}{
+		{
+			name:            "shifts more traffic to a healthy canary during the analysis period",
+			successRate:     metricsfake.Sample(99),
+			elapsed:         time.Minute,
+			expectedPhase:   v1.CanaryDeploymentPhaseEvaluating,
+			expectedWeights: []int32{85, 15},
+		},
+		{
+			name:          "promotes a canary which stayed healthy for the analysis period",
+			successRate:   metricsfake.Sample(99),
+			elapsed:       time.Hour,
+			expectedPhase: v1.CanaryDeploymentPhasePromoting,
+		},
+		{
+			name:          "rolls back a canary below the success threshold",
+			successRate:   metricsfake.Sample(80),
+			elapsed:       time.Minute,
+			expectedPhase: v1.CanaryDeploymentPhaseRollBack,
+		},
+		{
+			name:        "fails until the canary's metrics arrive",
+			elapsed:     time.Minute,
+			expectedErr: metrics.ErrNoData,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			// a sample CanaryDeployment in phase Evaluating
+			canaryDeployment := workertest.CanaryDeployment(v1.CanaryDeploymentPhaseEvaluating)
+			canaryDeployment.Spec = v1.CanaryDeploymentSpec{
+				MeasurementInterval: metav1.Duration{Duration: time.Minute},
+				SuccessThreshold:    95,
+				AnalysisPeriod:      metav1.Duration{Duration: 10 * time.Minute},
+			}
+			canaryDeployment.Status.PhaseEntryTime = metav1.NewTime(time.Now().Add(-tc.elapsed))
+
+			// return the canned success rate of the canary
+			metricsClient := metricsfake.NewClient()
+			if tc.successRate != nil {
+				metricsClient.OnQuery("istio_requests_total", tc.successRate)
+			}
+
+			// the virtual service after the Waiting phase shifted 10% of traffic to the canary
+			inputs := Inputs{
+				Metrics: canarydeploymentmetrics.NewMetricsClient(metricsClient),
+				VirtualServices: parameters.VirtualServices{
+					Items: []parameters.VirtualService{
+						workertest.VirtualService("example", 90, 10),
+					},
+				},
+			}
+			inputs.BuildIndexes()
+
+			// an in-memory client, seeded with the CanaryDeployment and the inputs, which the worker may also read directly
+			client := workertest.NewClient(t, canaryDeployment, &inputs.VirtualServices)
+
+			worker := &Worker{
+				Client:   client,
+				Logger:   zap.Logger(true),
+				Recorder: record.NewFakeRecorder(100),
+			}
+
+			outputs, nextPhase, _, _, err := worker.Sync(context.TODO(), canaryDeployment, inputs)
+			if errors.Cause(err) != tc.expectedErr {
+				t.Fatalf("expected error %v, got %v", tc.expectedErr, err)
+			}
+			if nextPhase != tc.expectedPhase {
+				t.Errorf("expected next phase %v, got %v", tc.expectedPhase, nextPhase)
+			}
+
+			// the success rate of the canary is measured over the measurement interval
+			queries := metricsClient.Queries()
+			if len(queries) != 1 || !strings.Contains(queries[0], `destination_workload=~"example-canary"`) || !strings.Contains(queries[0], "[1m]") {
+				t.Errorf("expected a success rate query for the canary over 1m, got %v", queries)
+			}
+
+			if tc.expectedWeights == nil {
+				if len(outputs.VirtualServices.Items) != 0 {
+					t.Errorf("expected no virtual services, got %v", len(outputs.VirtualServices.Items))
+				}
+				return
+			}
+			if len(outputs.VirtualServices.Items) != 1 {
+				t.Fatalf("expected 1 virtual service, got %v", len(outputs.VirtualServices.Items))
+			}
+			primaryWeight, canaryWeight, err := weights.GetWeights(outputs.VirtualServices.Items[0])
+			if err != nil {
+				t.Fatal(err)
+			}
+			if primaryWeight != tc.expectedWeights[0] || canaryWeight != tc.expectedWeights[1] {
+				t.Errorf("expected weights %v/%v, got %v/%v", tc.expectedWeights[0], tc.expectedWeights[1], primaryWeight, canaryWeight)
+			}
+
+			// write the outputs as the scheduler would
+			workertest.WriteOutputs(t, client, canaryDeployment, &outputs.VirtualServices)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package initializing
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -17,6 +18,7 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/solo-io/autopilot/pkg/ezkube"
+	"k8s.io/client-go/tools/record"
 
 	v1 "github.com/solo-io/autopilot/test/e2e/canary/pkg/apis/canarydeployments/v1"
 )
@@ -26,18 +28,21 @@ import (
 type Worker struct {
 	Client ezkube.Client
 	Logger logr.Logger
+
+	// records Kubernetes Events for the CanaryDeployment
+	Recorder record.EventRecorder
 }
 
-func (w *Worker) Sync(ctx context.Context, canary *v1.CanaryDeployment, inputs Inputs) (Outputs, v1.CanaryDeploymentPhase, *v1.CanaryDeploymentStatusInfo, error) {
+func (w *Worker) Sync(ctx context.Context, canary *v1.CanaryDeployment, inputs Inputs) (Outputs, v1.CanaryDeploymentPhase, time.Duration, *v1.CanaryDeploymentStatusInfo, error) {
 
 	targetDeployment, ok := inputs.FindDeployment(canary.Name, canary.Namespace)
 	if !ok {
-		return Outputs{}, "", nil, errors.Errorf("primary deployment not found for canary %v", canary.Name)
+		return Outputs{}, "", 0, nil, errors.Errorf("primary deployment not found for canary %v", canary.Name)
 	}
 	targetDeployment.Spec.Replicas = pointer.Int32Ptr(0)
 
 	if targetDeployment.Spec.Template.Labels == nil {
-		return Outputs{}, "", nil, errors.Errorf("invalid target deployment %v missing labels", canary.Name)
+		return Outputs{}, "", 0, nil, errors.Errorf("invalid target deployment %v missing labels", canary.Name)
 	}
 
 	primaryName := canary.Name + "-primary"
@@ -131,6 +136,7 @@ func (w *Worker) Sync(ctx context.Context, canary *v1.CanaryDeployment, inputs I
 			},
 		},
 		v1.CanaryDeploymentPhaseWaiting,
+		0,
 		nil,
 		nil
 }
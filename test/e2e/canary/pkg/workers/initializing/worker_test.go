@@ -0,0 +1,85 @@
+package initializing
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/solo-io/autopilot/test/e2e/canary/pkg/apis/canarydeployments/v1"
+	parameters "github.com/solo-io/autopilot/test/e2e/canary/pkg/parameters"
+	"github.com/solo-io/autopilot/test/e2e/canary/pkg/weights"
+	"github.com/solo-io/autopilot/test/e2e/canary/pkg/workers/workertest"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+)
+
+func TestSync(t *testing.T) {
+	// a sample CanaryDeployment in phase Initializing
+	canaryDeployment := workertest.CanaryDeployment(v1.CanaryDeploymentPhaseInitializing)
+	canaryDeployment.Spec.Ports = []int32{9080}
+
+	// the target deployment named by the CanaryDeployment
+	inputs := Inputs{
+		Deployments: parameters.Deployments{
+			Items: []parameters.Deployment{
+				workertest.Deployment("example", "petstore:v1", 2, ""),
+			},
+		},
+	}
+	inputs.BuildIndexes()
+
+	// an in-memory client, seeded with the CanaryDeployment and the inputs, which the worker may also read directly
+	client := workertest.NewClient(t, canaryDeployment, &inputs.Deployments)
+
+	worker := &Worker{
+		Client:   client,
+		Logger:   zap.Logger(true),
+		Recorder: record.NewFakeRecorder(100),
+	}
+
+	outputs, nextPhase, _, statusInfo, err := worker.Sync(context.TODO(), canaryDeployment, inputs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nextPhase != v1.CanaryDeploymentPhaseWaiting {
+		t.Errorf("expected next phase %v, got %v", v1.CanaryDeploymentPhaseWaiting, nextPhase)
+	}
+	if statusInfo != nil {
+		t.Errorf("expected no status info, got %v", statusInfo)
+	}
+
+	// the target is scaled down, and replaced by a primary and a scaled down canary
+	expectedReplicas := map[string]int32{"example": 0, "example-primary": 1, "example-canary": 0}
+	if len(outputs.Deployments.Items) != len(expectedReplicas) {
+		t.Fatalf("expected %v deployments, got %v", len(expectedReplicas), len(outputs.Deployments.Items))
+	}
+	for _, deployment := range outputs.Deployments.Items {
+		replicas, ok := expectedReplicas[deployment.Name]
+		if !ok {
+			t.Errorf("unexpected deployment %v", deployment.Name)
+			continue
+		}
+		if *deployment.Spec.Replicas != replicas {
+			t.Errorf("expected %v replicas of %v, got %v", replicas, deployment.Name, *deployment.Spec.Replicas)
+		}
+	}
+
+	// the primary, the canary and the front service which splits traffic between them
+	if len(outputs.Services.Items) != 3 {
+		t.Errorf("expected 3 services, got %v", len(outputs.Services.Items))
+	}
+
+	// all traffic is routed to the primary
+	if len(outputs.VirtualServices.Items) != 1 {
+		t.Fatalf("expected 1 virtual service, got %v", len(outputs.VirtualServices.Items))
+	}
+	primaryWeight, canaryWeight, err := weights.GetWeights(outputs.VirtualServices.Items[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if primaryWeight != 100 || canaryWeight != 0 {
+		t.Errorf("expected weights 100/0, got %v/%v", primaryWeight, canaryWeight)
+	}
+
+	// write the outputs as the scheduler would
+	workertest.WriteOutputs(t, client, canaryDeployment, &outputs.Deployments, &outputs.Services, &outputs.VirtualServices)
+}
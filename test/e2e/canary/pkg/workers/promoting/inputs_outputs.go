@@ -3,35 +3,111 @@
 package promoting
 
 import (
+	"github.com/solo-io/autopilot/pkg/scheduler"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	parameters "github.com/solo-io/autopilot/test/e2e/canary/pkg/parameters"
 )
 
 type Inputs struct {
 	Deployments     parameters.Deployments
 	VirtualServices parameters.VirtualServices
+
+	// map-backed indexes built by BuildIndexes
+	deploymentsIndex     *scheduler.InputIndex
+	virtualServicesIndex *scheduler.InputIndex
+}
+
+// BuildIndexes builds the map-backed indexes used to look up the Inputs.
+// It is called by the scheduler before the Inputs are passed to the worker.
+// Inputs constructed elsewhere (e.g. in tests) build their indexes on the first lookup,
+// and rebuild them when the number of Items changes.
+func (i *Inputs) BuildIndexes() {
+	i.deploymentsIndex = scheduler.NewInputIndex(len(i.Deployments.Items), func(idx int) metav1.Object {
+		return &i.Deployments.Items[idx]
+	})
+	i.virtualServicesIndex = scheduler.NewInputIndex(len(i.VirtualServices.Items), func(idx int) metav1.Object {
+		return &i.VirtualServices.Items[idx]
+	})
+}
+
+func (i *Inputs) indexDeployments() *scheduler.InputIndex {
+	if i.deploymentsIndex == nil || i.deploymentsIndex.Len() != len(i.Deployments.Items) {
+		i.BuildIndexes()
+	}
+	return i.deploymentsIndex
 }
 
 // FindDeployment returns <Deployment, true> if the item is found. else parameters.Deployment{}, false
-func (i Inputs) FindDeployment(name, namespace string) (parameters.Deployment, bool) {
-	for _, item := range i.Deployments.Items {
-		if item.Name == name && item.Namespace == namespace {
-			return item, true
-		}
+func (i *Inputs) FindDeployment(name, namespace string) (parameters.Deployment, bool) {
+	if idx, ok := i.indexDeployments().Find(name, namespace); ok {
+		return i.Deployments.Items[idx], true
 	}
 	return parameters.Deployment{}, false
 }
 
+// ByDeploymentLabel returns the Deployments whose label key has the given value
+func (i *Inputs) ByDeploymentLabel(key, value string) []parameters.Deployment {
+	var items []parameters.Deployment
+	for _, idx := range i.indexDeployments().ByLabel(key, value) {
+		items = append(items, i.Deployments.Items[idx])
+	}
+	return items
+}
+
+// DeploymentsOwnedBy returns the Deployments with an owner reference to the given owner
+func (i *Inputs) DeploymentsOwnedBy(owner metav1.Object) []parameters.Deployment {
+	var items []parameters.Deployment
+	for _, idx := range i.indexDeployments().OwnedBy(owner.GetUID()) {
+		items = append(items, i.Deployments.Items[idx])
+	}
+	return items
+}
+
+func (i *Inputs) indexVirtualServices() *scheduler.InputIndex {
+	if i.virtualServicesIndex == nil || i.virtualServicesIndex.Len() != len(i.VirtualServices.Items) {
+		i.BuildIndexes()
+	}
+	return i.virtualServicesIndex
+}
+
 // FindVirtualService returns <VirtualService, true> if the item is found. else parameters.VirtualService{}, false
-func (i Inputs) FindVirtualService(name, namespace string) (parameters.VirtualService, bool) {
-	for _, item := range i.VirtualServices.Items {
-		if item.Name == name && item.Namespace == namespace {
-			return item, true
-		}
+func (i *Inputs) FindVirtualService(name, namespace string) (parameters.VirtualService, bool) {
+	if idx, ok := i.indexVirtualServices().Find(name, namespace); ok {
+		return i.VirtualServices.Items[idx], true
 	}
 	return parameters.VirtualService{}, false
 }
 
+// ByVirtualServiceLabel returns the VirtualServices whose label key has the given value
+func (i *Inputs) ByVirtualServiceLabel(key, value string) []parameters.VirtualService {
+	var items []parameters.VirtualService
+	for _, idx := range i.indexVirtualServices().ByLabel(key, value) {
+		items = append(items, i.VirtualServices.Items[idx])
+	}
+	return items
+}
+
+// VirtualServicesOwnedBy returns the VirtualServices with an owner reference to the given owner
+func (i *Inputs) VirtualServicesOwnedBy(owner metav1.Object) []parameters.VirtualService {
+	var items []parameters.VirtualService
+	for _, idx := range i.indexVirtualServices().OwnedBy(owner.GetUID()) {
+		items = append(items, i.VirtualServices.Items[idx])
+	}
+	return items
+}
+
 type Outputs struct {
 	Deployments     parameters.Deployments
 	VirtualServices parameters.VirtualServices
+
+	// objects for the scheduler to delete after writing the outputs above
+	Delete Deletions
+}
+
+// Deletions are deleted by the scheduler after it writes the Outputs.
+// Objects which no longer exist are ignored.
+type Deletions struct {
+	Deployments     parameters.Deployments
+	VirtualServices parameters.VirtualServices
 }
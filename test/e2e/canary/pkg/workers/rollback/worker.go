@@ -2,6 +2,7 @@ package rollback
 
 import (
 	"context"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/solo-io/autopilot/test/e2e/canary/pkg/parameters"
@@ -12,6 +13,7 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/solo-io/autopilot/pkg/ezkube"
+	"k8s.io/client-go/tools/record"
 
 	v1 "github.com/solo-io/autopilot/test/e2e/canary/pkg/apis/canarydeployments/v1"
 )
@@ -21,26 +23,29 @@ import (
 type Worker struct {
 	Client ezkube.Client
 	Logger logr.Logger
+
+	// records Kubernetes Events for the CanaryDeployment
+	Recorder record.EventRecorder
 }
 
-func (w *Worker) Sync(ctx context.Context, canary *v1.CanaryDeployment, inputs Inputs) (Outputs, v1.CanaryDeploymentPhase, *v1.CanaryDeploymentStatusInfo, error) {
+func (w *Worker) Sync(ctx context.Context, canary *v1.CanaryDeployment, inputs Inputs) (Outputs, v1.CanaryDeploymentPhase, time.Duration, *v1.CanaryDeploymentStatusInfo, error) {
 	canaryName := canary.Name + "-canary"
 
 	w.Logger.Info("rolling back canary... scaling down canary deployment and shifting all traffic back to primary...")
 
 	virtualService, ok := inputs.FindVirtualService(canary.Name, canary.Namespace)
 	if !ok {
-		return Outputs{}, "", nil, errors.Errorf("virtual service not found for canary %v", canary.Name)
+		return Outputs{}, "", 0, nil, errors.Errorf("virtual service not found for canary %v", canary.Name)
 	}
 
 	canaryDeployment, ok := inputs.FindDeployment(canaryName, canary.Namespace)
 	if !ok {
-		return Outputs{}, "", nil, errors.Errorf("canary deployment not found for canary %v", canary.Name)
+		return Outputs{}, "", 0, nil, errors.Errorf("canary deployment not found for canary %v", canary.Name)
 	}
 	canaryDeployment.Spec.Replicas = pointer.Int32Ptr(0)
 
 	if err := weights.SetWeights(&virtualService, 100, 0); err != nil {
-		return Outputs{}, "", nil, errors.Wrapf(err, "failed to set weights for virtual service for canary %v", canary.Name)
+		return Outputs{}, "", 0, nil, errors.Wrapf(err, "failed to set weights for virtual service for canary %v", canary.Name)
 	}
 
 	// append to the canary's history
@@ -62,5 +67,5 @@ func (w *Worker) Sync(ctx context.Context, canary *v1.CanaryDeployment, inputs I
 				virtualService,
 			},
 		},
-	}, v1.CanaryDeploymentPhaseWaiting, &status, nil
+	}, v1.CanaryDeploymentPhaseWaiting, 0, &status, nil
 }
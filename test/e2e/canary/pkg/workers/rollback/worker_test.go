@@ -0,0 +1,91 @@
+package rollback
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	v1 "github.com/solo-io/autopilot/test/e2e/canary/pkg/apis/canarydeployments/v1"
+	parameters "github.com/solo-io/autopilot/test/e2e/canary/pkg/parameters"
+	"github.com/solo-io/autopilot/test/e2e/canary/pkg/weights"
+	"github.com/solo-io/autopilot/test/e2e/canary/pkg/workers/workertest"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+)
+
+func TestSync(t *testing.T) {
+	// a sample CanaryDeployment in phase RollBack, which was promoted once before
+	canaryDeployment := workertest.CanaryDeployment(v1.CanaryDeploymentPhaseRollBack)
+	canaryDeployment.Status.History = []v1.CanaryResult{{PromotionSucceeded: true, ObservedGeneration: 1}}
+
+	// the primary, the evaluated canary, and the virtual service splitting traffic between them
+	canary := workertest.Deployment("example-canary", "petstore:v2", 1, "true")
+	canary.Status.ObservedGeneration = 3
+	inputs := Inputs{
+		Deployments: parameters.Deployments{
+			Items: []parameters.Deployment{
+				workertest.Deployment("example-primary", "petstore:v1", 1, "false"),
+				canary,
+			},
+		},
+		VirtualServices: parameters.VirtualServices{
+			Items: []parameters.VirtualService{
+				workertest.VirtualService("example", 50, 50),
+			},
+		},
+	}
+	inputs.BuildIndexes()
+
+	// an in-memory client, seeded with the CanaryDeployment and the inputs, which the worker may also read directly
+	client := workertest.NewClient(t, canaryDeployment, &inputs.Deployments, &inputs.VirtualServices)
+
+	worker := &Worker{
+		Client:   client,
+		Logger:   zap.Logger(true),
+		Recorder: record.NewFakeRecorder(100),
+	}
+
+	outputs, nextPhase, _, statusInfo, err := worker.Sync(context.TODO(), canaryDeployment, inputs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nextPhase != v1.CanaryDeploymentPhaseWaiting {
+		t.Errorf("expected next phase %v, got %v", v1.CanaryDeploymentPhaseWaiting, nextPhase)
+	}
+
+	// only the canary is scaled down, the primary is left unchanged
+	if len(outputs.Deployments.Items) != 1 {
+		t.Fatalf("expected 1 deployment, got %v", len(outputs.Deployments.Items))
+	}
+	canary = outputs.Deployments.Items[0]
+	if canary.Name != "example-canary" {
+		t.Errorf("expected the canary deployment, got %v", canary.Name)
+	}
+	if replicas := *canary.Spec.Replicas; replicas != 0 {
+		t.Errorf("expected 0 canary replicas, got %v", replicas)
+	}
+
+	// all traffic is routed back to the primary
+	if len(outputs.VirtualServices.Items) != 1 {
+		t.Fatalf("expected 1 virtual service, got %v", len(outputs.VirtualServices.Items))
+	}
+	primaryWeight, canaryWeight, err := weights.GetWeights(outputs.VirtualServices.Items[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if primaryWeight != 100 || canaryWeight != 0 {
+		t.Errorf("expected weights 100/0, got %v/%v", primaryWeight, canaryWeight)
+	}
+
+	// the rollback is appended to the history
+	expectedHistory := []v1.CanaryResult{
+		{PromotionSucceeded: true, ObservedGeneration: 1},
+		{PromotionSucceeded: false, ObservedGeneration: 3},
+	}
+	if statusInfo == nil || !reflect.DeepEqual(statusInfo.History, expectedHistory) {
+		t.Errorf("expected history %v, got %v", expectedHistory, statusInfo)
+	}
+
+	// write the outputs as the scheduler would
+	workertest.WriteOutputs(t, client, canaryDeployment, &outputs.Deployments, &outputs.VirtualServices)
+}
@@ -3,6 +3,7 @@ package waiting
 import (
 	"context"
 	"reflect"
+	"time"
 
 	"github.com/solo-io/autopilot/test/e2e/canary/pkg/weights"
 	"istio.io/client-go/pkg/apis/networking/v1alpha3"
@@ -10,13 +11,14 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/utils/pointer"
 
-	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
-	"github.com/solo-io/autopilot/pkg/ezkube"
 	"github.com/solo-io/autopilot/test/e2e/canary/pkg/parameters"
 
+	"github.com/go-logr/logr"
+	"github.com/solo-io/autopilot/pkg/ezkube"
+	"k8s.io/client-go/tools/record"
+
 	v1 "github.com/solo-io/autopilot/test/e2e/canary/pkg/apis/canarydeployments/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
@@ -24,9 +26,12 @@ import (
 type Worker struct {
 	Client ezkube.Client
 	Logger logr.Logger
+
+	// records Kubernetes Events for the CanaryDeployment
+	Recorder record.EventRecorder
 }
 
-func (w *Worker) Sync(ctx context.Context, canary *v1.CanaryDeployment, inputs Inputs) (Outputs, v1.CanaryDeploymentPhase, *v1.CanaryDeploymentStatusInfo, error) {
+func (w *Worker) Sync(ctx context.Context, canary *v1.CanaryDeployment, inputs Inputs) (Outputs, v1.CanaryDeploymentPhase, time.Duration, *v1.CanaryDeploymentStatusInfo, error) {
 	canaryName := canary.Name + "-canary"
 	logger := w.Logger.WithValues("canaryName", canaryName)
 
@@ -34,17 +39,17 @@ func (w *Worker) Sync(ctx context.Context, canary *v1.CanaryDeployment, inputs I
 
 	canaryDeployment, ok := inputs.FindDeployment(canaryName, canary.Namespace)
 	if !ok {
-		return Outputs{}, "", nil, errors.Errorf("deployment %v not found for canary %v", canaryName, canary.Name)
+		return Outputs{}, "", 0, nil, errors.Errorf("deployment %v not found for canary %v", canaryName, canary.Name)
 	}
 
 	targetDeployment, ok := inputs.FindDeployment(canary.Name, canary.Namespace)
 	if !ok {
-		return Outputs{}, "", nil, errors.Errorf("primary deployment not found for canary %v", canary.Name)
+		return Outputs{}, "", 0, nil, errors.Errorf("primary deployment not found for canary %v", canary.Name)
 	}
 
 	if deploymentsEqual(targetDeployment.Spec, canaryDeployment.Spec) {
 		w.Logger.Info("canary has not changed")
-		return Outputs{}, v1.CanaryDeploymentPhaseWaiting, nil, nil
+		return Outputs{}, v1.CanaryDeploymentPhaseWaiting, 0, nil, nil
 	}
 
 	logger.Info("diff", "canary", canaryDeployment.Spec.Template, "target", targetDeployment.Spec.Template)
@@ -59,12 +64,12 @@ func (w *Worker) Sync(ctx context.Context, canary *v1.CanaryDeployment, inputs I
 
 	virtualService, ok := inputs.FindVirtualService(canary.Name, canary.Namespace)
 	if !ok {
-		return Outputs{}, "", nil, errors.Errorf("virtual service not found for canary %v", canary.Name)
+		return Outputs{}, "", 0, nil, errors.Errorf("virtual service not found for canary %v", canary.Name)
 	}
 
 	// kick off the analysis with 10% split
 	if err := weights.StepWeights(&virtualService, 10); err != nil {
-		return Outputs{}, "", nil, errors.Wrapf(err, "failed to step virtual service weights for canary %v", canary.Name)
+		return Outputs{}, "", 0, nil, errors.Wrapf(err, "failed to step virtual service weights for canary %v", canary.Name)
 	}
 
 	return Outputs{
@@ -80,7 +85,8 @@ func (w *Worker) Sync(ctx context.Context, canary *v1.CanaryDeployment, inputs I
 			},
 		},
 		v1.CanaryDeploymentPhaseEvaluating,
-		&v1.CanaryDeploymentStatusInfo{TimeStarted: metav1.Now(), History: canary.Status.History},
+		0,
+		nil,
 		nil
 }
 
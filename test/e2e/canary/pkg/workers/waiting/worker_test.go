@@ -0,0 +1,108 @@
+package waiting
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/solo-io/autopilot/test/e2e/canary/pkg/apis/canarydeployments/v1"
+	parameters "github.com/solo-io/autopilot/test/e2e/canary/pkg/parameters"
+	"github.com/solo-io/autopilot/test/e2e/canary/pkg/weights"
+	"github.com/solo-io/autopilot/test/e2e/canary/pkg/workers/workertest"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+)
+
+func TestSync(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+
+		// the image of the target deployment, which the user modifies to start a canary
+		targetImage string
+
+		expectedPhase   v1.CanaryDeploymentPhase
+		expectedOutputs int
+	}{
+		{
+			name:          "keeps waiting while the target is unchanged",
+			targetImage:   "petstore:v1",
+			expectedPhase: v1.CanaryDeploymentPhaseWaiting,
+		},
+		{
+			name:            "scales up the canary when the target changes",
+			targetImage:     "petstore:v2",
+			expectedPhase:   v1.CanaryDeploymentPhaseEvaluating,
+			expectedOutputs: 1,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			// a sample CanaryDeployment in phase Waiting
+			canaryDeployment := workertest.CanaryDeployment(v1.CanaryDeploymentPhaseWaiting)
+
+			// the target, the scaled down canary, and the virtual service created by the Initializing phase
+			inputs := Inputs{
+				Deployments: parameters.Deployments{
+					Items: []parameters.Deployment{
+						workertest.Deployment("example", tc.targetImage, 0, ""),
+						workertest.Deployment("example-canary", "petstore:v1", 0, ""),
+					},
+				},
+				VirtualServices: parameters.VirtualServices{
+					Items: []parameters.VirtualService{
+						workertest.VirtualService("example", 100, 0),
+					},
+				},
+			}
+			inputs.BuildIndexes()
+
+			// an in-memory client, seeded with the CanaryDeployment and the inputs, which the worker may also read directly
+			client := workertest.NewClient(t, canaryDeployment, &inputs.Deployments, &inputs.VirtualServices)
+
+			worker := &Worker{
+				Client:   client,
+				Logger:   zap.Logger(true),
+				Recorder: record.NewFakeRecorder(100),
+			}
+
+			outputs, nextPhase, _, statusInfo, err := worker.Sync(context.TODO(), canaryDeployment, inputs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if nextPhase != tc.expectedPhase {
+				t.Errorf("expected next phase %v, got %v", tc.expectedPhase, nextPhase)
+			}
+			if statusInfo != nil {
+				t.Errorf("expected no status info, got %v", statusInfo)
+			}
+			if len(outputs.Deployments.Items) != tc.expectedOutputs || len(outputs.VirtualServices.Items) != tc.expectedOutputs {
+				t.Fatalf("expected %v deployments and virtual services, got %v and %v", tc.expectedOutputs, len(outputs.Deployments.Items), len(outputs.VirtualServices.Items))
+			}
+			if tc.expectedOutputs == 0 {
+				return
+			}
+
+			// the canary runs the modified target with a single replica
+			canary := outputs.Deployments.Items[0]
+			if canary.Name != "example-canary" {
+				t.Errorf("expected the canary deployment, got %v", canary.Name)
+			}
+			if image := canary.Spec.Template.Spec.Containers[0].Image; image != tc.targetImage {
+				t.Errorf("expected canary image %v, got %v", tc.targetImage, image)
+			}
+			if replicas := *canary.Spec.Replicas; replicas != 1 {
+				t.Errorf("expected 1 canary replica, got %v", replicas)
+			}
+
+			// 10% of traffic is shifted to the canary
+			primaryWeight, canaryWeight, err := weights.GetWeights(outputs.VirtualServices.Items[0])
+			if err != nil {
+				t.Fatal(err)
+			}
+			if primaryWeight != 90 || canaryWeight != 10 {
+				t.Errorf("expected weights 90/10, got %v/%v", primaryWeight, canaryWeight)
+			}
+
+			// write the outputs as the scheduler would
+			workertest.WriteOutputs(t, client, canaryDeployment, &outputs.Deployments, &outputs.VirtualServices)
+		})
+	}
+}
@@ -0,0 +1,125 @@
+// Package workertest provides the example objects and the in-memory client shared by the tests of the canary workers
+package workertest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/solo-io/autopilot/pkg/ezkube"
+	"github.com/solo-io/autopilot/pkg/ezkube/fake"
+	"github.com/solo-io/autopilot/pkg/run"
+	v1 "github.com/solo-io/autopilot/test/e2e/canary/pkg/apis/canarydeployments/v1"
+	parameters "github.com/solo-io/autopilot/test/e2e/canary/pkg/parameters"
+	istiov1alpha3 "istio.io/api/networking/v1alpha3"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
+)
+
+// the namespace of the example objects
+const Namespace = "default"
+
+// CanaryDeployment returns a CanaryDeployment named example in the given phase
+func CanaryDeployment(phase v1.CanaryDeploymentPhase) *v1.CanaryDeployment {
+	canaryDeployment := &v1.CanaryDeployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "example",
+			Namespace: Namespace,
+		},
+	}
+	canaryDeployment.Status.Phase = phase
+	return canaryDeployment
+}
+
+// Deployment returns a deployment of the example app running the given image.
+// the deployment and its pods are labeled with the canary label, unless it is empty
+func Deployment(name, image string, replicas int32, canaryLabel string) parameters.Deployment {
+	labels := map[string]string{"app": "example"}
+	if canaryLabel != "" {
+		labels["canary"] = canaryLabel
+	}
+	return parameters.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: Namespace,
+			Labels:    labels,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: pointer.Int32Ptr(replicas),
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "petstore", Image: image}},
+				},
+			},
+		},
+	}
+}
+
+// VirtualService returns a virtual service splitting the traffic of the example app
+// between its primary and canary services with the given weights
+func VirtualService(name string, primaryWeight, canaryWeight int32) parameters.VirtualService {
+	return parameters.VirtualService{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: Namespace,
+		},
+		Spec: istiov1alpha3.VirtualService{
+			Hosts: []string{name},
+			Http: []*istiov1alpha3.HTTPRoute{{
+				Name: "split-9080",
+				Route: []*istiov1alpha3.HTTPRouteDestination{
+					{Destination: &istiov1alpha3.Destination{Host: name + "-primary"}, Weight: primaryWeight},
+					{Destination: &istiov1alpha3.Destination{Host: name + "-canary"}, Weight: canaryWeight},
+				},
+			}},
+		},
+	}
+}
+
+// NewClient returns an in-memory client seeded with the CanaryDeployment and the items of the given input lists,
+// which the worker may also read directly
+func NewClient(t *testing.T, canaryDeployment *v1.CanaryDeployment, inputs ...runtime.Object) *fake.Client {
+	t.Helper()
+	scheme, err := run.NewScheme()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := v1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	objs := []runtime.Object{canaryDeployment}
+	for _, list := range inputs {
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			t.Fatal(err)
+		}
+		objs = append(objs, items...)
+	}
+	client, err := fake.NewClient(scheme, objs...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+// WriteOutputs writes the items of the given output lists as the scheduler would
+func WriteOutputs(t *testing.T, client ezkube.Ensurer, canaryDeployment *v1.CanaryDeployment, outputs ...runtime.Object) {
+	t.Helper()
+	for _, list := range outputs {
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, item := range items {
+			out := item.(ezkube.Object)
+			if _, err := client.Ensure(context.TODO(), canaryDeployment, out); err != nil {
+				t.Errorf("failed to write output %v: %v", out.GetName(), err)
+			}
+		}
+	}
+}